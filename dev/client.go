@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"github.com/minderjan/opentransport-client/opentransport"
 	"io"
+	"net/http"
 	"os"
 	"time"
 )
@@ -16,7 +17,10 @@ func main() {
 	apiUrl := "http://localhost:3001/v1/"
 
 	// Create client against custom remote api
-	client, err := opentransport.NewClientWithUrl(nil, apiUrl)
+	client, err := opentransport.New(
+		opentransport.WithBaseURL(apiUrl),
+		opentransport.WithUserAgent("OpenTransport Development"),
+	)
 	if err != nil {
 		fmt.Printf("Could not create the opentransport client with url base url %s: %s",  apiUrl, err)
 		os.Exit(1)
@@ -27,9 +31,6 @@ func main() {
 
 	// logsWithFile(client)
 
-	// Change User Agent
-	client.UserAgent("OpenTransport Development")
-
 	// Configure error behaviour
 	httpRetryOptions(client)
 
@@ -47,16 +48,23 @@ func main() {
 	// stationboardWithDate(client)
 	// stationboardWithType(client)
 	// stationboardWithOpts(client)
+	//
+	// requestHeaders(client)
 
 }
 
 // Change retry configs. These will be used if the server resonpds with an > http 500 or a go error.
 func httpRetryOptions(client *opentransport.Client) {
 
-	// If an http error occur, the client try
-	err := client.MaxRetry(2, 1)
+	// If an http error occur, the client try up to 3 times with a growing pause
+	client, err := client.With(opentransport.WithRetryPolicy(opentransport.ExponentialBackoff{
+		Attempts: 3,
+		Initial:  500 * time.Millisecond,
+		Max:      5 * time.Second,
+	}))
 	if err != nil {
-		fmt.Printf("Failed to set max retry options: %s", err)
+		fmt.Printf("ERROR: %s", err)
+		return
 	}
 
 	// Define Timeout
@@ -350,3 +358,32 @@ func stationboardWithOpts(client *opentransport.Client) {
 			j.To)
 	}
 }
+
+// Send static and per-call headers to the headers route of the mocked API, which responds with the received headers.
+func requestHeaders(client *opentransport.Client) {
+	hClient, err := client.With(
+		opentransport.WithHeader("X-Api-Key", "development"),
+		opentransport.WithHeader("Accept-Language", "de-CH"),
+	)
+	if err != nil {
+		fmt.Printf("Could not configure headers: %s", err)
+		os.Exit(1)
+	}
+
+	// Headers for a single call
+	ctx := opentransport.ContextWithHeaders(context.Background(), http.Header{"X-Correlation-Id": []string{"dev-client"}})
+
+	req, err := hClient.NewRequest(ctx, "headers")
+	if err != nil {
+		fmt.Printf("Could not create request: %s", err)
+		os.Exit(1)
+	}
+
+	body, err := hClient.Do(req)
+	if err != nil {
+		fmt.Printf("The mock server rejected the headers: %s", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Headers received by the mock server: %s\n", body)
+}
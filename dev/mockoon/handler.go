@@ -0,0 +1,255 @@
+package mockoon
+
+import (
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Configuration of a handler
+type Options struct {
+	DisableLatency bool        // Ignores the latency of the environment and the responses.
+	Logger         *log.Logger // Logs every request with the chosen response. Default is no logging.
+}
+
+// Serves the routes of an environment
+type handler struct {
+	env  *Environment
+	opts Options
+}
+
+// The templating helpers, e.g. {{ now }} or {{header 'User-Agent'}}
+var helperPattern = regexp.MustCompile(`{{\s*(\w+)(?:\s+'([^']*)')?(?:\s+'([^']*)')?\s*}}`)
+
+// Creates a http handler, which serves the routes of the environment.
+//
+// Returns a http.Handler
+func NewHandler(env *Environment, opts Options) http.Handler {
+	return &handler{env: env, opts: opts}
+}
+
+// Finds the route and the response of a request and writes the response
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.env.CORS {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "GET,POST,PUT,PATCH,DELETE,HEAD,OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Origin, Accept, Authorization, Content-Length, X-Requested-With")
+		if r.Method == http.MethodOptions {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+	}
+
+	route, params, ok := h.route(r)
+	if !ok {
+		h.logf("%s %s: no route", r.Method, r.URL)
+		http.NotFound(w, r)
+		return
+	}
+
+	body, _ := ioutil.ReadAll(r.Body)
+	res := chooseResponse(route.Responses, r, params, string(body))
+	h.logf("%s %s: %s", r.Method, r.URL, res.Label)
+
+	if !h.opts.DisableLatency {
+		if !sleep(r, time.Duration(h.env.Latency+res.Latency)*time.Millisecond) {
+			return
+		}
+	}
+
+	for _, header := range append(append([]Header(nil), h.env.Headers...), res.Headers...) {
+		if header.Key != "" {
+			w.Header().Set(header.Key, header.Value)
+		}
+	}
+
+	content := []byte(res.Body)
+	if res.SendFileAsBody && res.FilePath != "" {
+		file := res.FilePath
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(h.env.dir, file)
+		}
+		raw, err := ioutil.ReadFile(file)
+		if err != nil {
+			h.logf("failed to read file of response %s: %s", res.Label, err)
+			http.Error(w, "failed to read the file of the response", http.StatusInternalServerError)
+			return
+		}
+		content = raw
+	}
+
+	w.WriteHeader(int(res.StatusCode))
+	_, _ = w.Write([]byte(render(string(content), r)))
+}
+
+// Finds the enabled route of the method and the path.
+//
+// Returns the route, the route parameters and false if no route matches
+func (h *handler) route(r *http.Request) (Route, map[string]string, bool) {
+	path := strings.Trim(r.URL.Path, "/")
+	if prefix := strings.Trim(h.env.EndpointPrefix, "/"); prefix != "" {
+		if path != prefix && !strings.HasPrefix(path, prefix+"/") {
+			return Route{}, nil, false
+		}
+		path = strings.TrimPrefix(strings.TrimPrefix(path, prefix), "/")
+	}
+
+	for _, route := range h.env.Routes {
+		if !route.Enabled || len(route.Responses) == 0 || !strings.EqualFold(route.Method, r.Method) {
+			continue
+		}
+		if params, ok := matchPath(strings.Trim(route.Endpoint, "/"), path); ok {
+			return route, params, true
+		}
+	}
+	return Route{}, nil, false
+}
+
+// Matches a path against an endpoint with parameters, e.g. stations/:id.
+//
+// Returns the values of the parameters and false if the path does not match
+func matchPath(endpoint string, path string) (map[string]string, bool) {
+	if endpoint == path {
+		return nil, true
+	}
+
+	want, got := strings.Split(endpoint, "/"), strings.Split(path, "/")
+	if len(want) != len(got) {
+		return nil, false
+	}
+
+	params := make(map[string]string)
+	for i, segment := range want {
+		if strings.HasPrefix(segment, ":") {
+			params[segment[1:]] = got[i]
+			continue
+		}
+		if segment != got[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+// Chooses the first response with rules, which all match the request, like Mockoon does.
+//
+// Returns the chosen response or the first response as default
+func chooseResponse(responses []Response, r *http.Request, params map[string]string, body string) Response {
+	for _, res := range responses {
+		if len(res.Rules) == 0 {
+			continue
+		}
+
+		matched := true
+		for _, rule := range res.Rules {
+			if !ruleMatches(rule, r, params, body) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return res
+		}
+	}
+	return responses[0]
+}
+
+// Reports whether a rule matches the request
+func ruleMatches(rule Rule, r *http.Request, params map[string]string, body string) bool {
+	var values []string
+	switch rule.Target {
+	case "query":
+		values = queryValues(r.URL.Query(), rule.Modifier)
+	case "header":
+		values = r.Header.Values(rule.Modifier)
+	case "params":
+		if v, ok := params[rule.Modifier]; ok {
+			values = []string{v}
+		}
+	case "body":
+		values = []string{body}
+	default:
+		return false
+	}
+
+	for _, v := range values {
+		if rule.IsRegex {
+			if re, err := regexp.Compile(rule.Value); err == nil && re.MatchString(v) {
+				return true
+			}
+			continue
+		}
+		if v == rule.Value {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the values of a query parameter. Array parameters like via[] are found by their name without brackets.
+func queryValues(query url.Values, name string) []string {
+	if values, ok := query[name]; ok {
+		return values
+	}
+	return query[name+"[]"]
+}
+
+// Replaces the supported templating helpers in a body. Unknown helpers are kept.
+func render(body string, r *http.Request) string {
+	if !strings.Contains(body, "{{") {
+		return body
+	}
+
+	return helperPattern.ReplaceAllStringFunc(body, func(helper string) string {
+		m := helperPattern.FindStringSubmatch(helper)
+		switch m[1] {
+		case "now":
+			return time.Now().Format(time.RFC3339)
+		case "header":
+			return escape(r.Header.Get(m[2]))
+		case "queryParam":
+			v := r.URL.Query().Get(m[2])
+			if v == "" {
+				v = m[3]
+			}
+			return escape(v)
+		}
+		return helper
+	})
+}
+
+// Escapes a value for a json string, because most bodies are json
+func escape(v string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`)
+	return r.Replace(v)
+}
+
+// Waits for the latency or until the request is canceled.
+//
+// Returns false if the request was canceled
+func sleep(r *http.Request, latency time.Duration) bool {
+	if latency <= 0 {
+		return true
+	}
+
+	timer := time.NewTimer(latency)
+	defer timer.Stop()
+	select {
+	case <-r.Context().Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// Writes a log message, if a logger is configured
+func (h *handler) logf(format string, v ...interface{}) {
+	if h.opts.Logger != nil {
+		h.opts.Logger.Printf(format, v...)
+	}
+}
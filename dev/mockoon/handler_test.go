@@ -0,0 +1,173 @@
+package mockoon
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minderjan/opentransport-client/opentransport"
+)
+
+// Starts a server with the environment of the dev directory without latency
+func newTestServer(t *testing.T) *httptest.Server {
+	env, err := Load("../opentransport-api-mocks.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return httptest.NewServer(NewHandler(env, Options{DisableLatency: true}))
+}
+
+// Sends a get request and returns the status and the body
+func get(t *testing.T, url string, header http.Header) (int, string) {
+	req, _ := http.NewRequest(http.MethodGet, url, nil)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	raw, _ := ioutil.ReadAll(res.Body)
+	return res.StatusCode, string(raw)
+}
+
+func TestHandler_Client(t *testing.T) {
+	ts := newTestServer(t)
+	defer ts.Close()
+
+	client, err := opentransport.New(opentransport.WithBaseURL(ts.URL + "/v1/"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	locations, err := client.Location.Search(ctx, "Bern")
+	if err != nil || len(locations) == 0 || locations[0].Id != "8507000" {
+		t.Errorf("The rule of the Bern response should match: %v", err)
+	}
+
+	locations, err = client.Location.SearchWithCoordinates(ctx, 47.403718, 8.557201)
+	if err != nil || len(locations) == 0 {
+		t.Errorf("The coordinate rules should match: %v", err)
+	}
+
+	result, err := client.Connection.SearchVia(ctx, "Bellevue", "Liebefeld", time.Now(), []string{"Olten"})
+	if err != nil || len(result.Connections) == 0 {
+		t.Errorf("The regex rule for the via array should match: %v", err)
+	}
+
+	stb, err := client.Stationboard.Search(ctx, "Waldgarten")
+	if err != nil || stb.Station.Id != "8591420" {
+		t.Errorf("The stationboard by name should be served: %v", err)
+	}
+
+	info, err := client.Info.Info(ctx)
+	if err != nil || time.Since(info.Date) > time.Minute {
+		t.Errorf("The now helper should be rendered: %v, %+v", err, info)
+	}
+}
+
+func TestHandler_Rules(t *testing.T) {
+	ts := newTestServer(t)
+	defer ts.Close()
+
+	// Without matching rules, the first response is the default
+	status, body := get(t, ts.URL+"/v1/locations?query=Genf", nil)
+	if status != http.StatusOK || !strings.Contains(body, `"stations": [ ]`) {
+		t.Errorf("Got %d %s instead of the default response", status, body)
+	}
+
+	status, body = get(t, ts.URL+"/v1/headers", http.Header{"User-Agent": {`My "Agent"`}, "X-Api-Key": {"secret"}})
+	var echo map[string]string
+	if err := json.Unmarshal([]byte(body), &echo); err != nil || status != http.StatusOK {
+		t.Fatalf("Got %d %s instead of the header echo: %v", status, body, err)
+	}
+	if echo["userAgent"] != `My "Agent"` || echo["apiKey"] != "secret" {
+		t.Errorf("The headers were not rendered: %v", echo)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, ts.URL+"/v1/headers", nil)
+	req.Header["User-Agent"] = []string{""}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusBadRequest {
+		t.Errorf("Got status %d instead of 400 without user agent", res.StatusCode)
+	}
+
+	for _, path := range []string{"/v1/trips", "/locations", "/v2/locations"} {
+		if status, _ := get(t, ts.URL+path, nil); status != http.StatusNotFound {
+			t.Errorf("Got status %d for %s instead of 404", status, path)
+		}
+	}
+
+	r, _ := http.NewRequest(http.MethodOptions, ts.URL+"/v1/locations", nil)
+	res, err = http.DefaultClient.Do(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusOK || res.Header.Get("Access-Control-Allow-Origin") != "*" {
+		t.Errorf("A preflight request should be answered with the CORS headers")
+	}
+}
+
+func TestHandler_FileAndParams(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mockoon")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	_ = ioutil.WriteFile(filepath.Join(dir, "station.json"), []byte(`{"id":"{{queryParam 'id' 'none'}}"}`), 0644)
+	_ = ioutil.WriteFile(filepath.Join(dir, "env.json"), []byte(`{"name":"files","latency":30,"headers":[{"key":"X-Env","value":"1"}],"routes":[
+		{"method":"get","endpoint":"stations/:id","enabled":true,"responses":[
+			{"label":"default","statusCode":"404","body":"{}"},
+			{"label":"file","statusCode":"200","filePath":"station.json","sendFileAsBody":true,"headers":[{"key":"X-Res","value":"2"}],
+				"rules":[{"target":"params","modifier":"id","value":"^85","isRegex":true}]}
+		]},
+		{"method":"post","endpoint":"disabled","enabled":false,"responses":[{"statusCode":"200"}]}
+	]}`), 0644)
+
+	env, err := Load(filepath.Join(dir, "env.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := httptest.NewServer(NewHandler(env, Options{}))
+	defer ts.Close()
+
+	start := time.Now()
+	res, err := http.Get(ts.URL + "/stations/8507000?id=8507000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, _ := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusOK || string(raw) != `{"id":"8507000"}` {
+		t.Errorf("Got %d %s instead of the rendered file", res.StatusCode, raw)
+	}
+	if res.Header.Get("X-Env") != "1" || res.Header.Get("X-Res") != "2" {
+		t.Errorf("The headers of the environment and the response are missing")
+	}
+	if time.Since(start) < 30*time.Millisecond {
+		t.Errorf("The latency of the environment was not applied")
+	}
+
+	if status, _ := get(t, ts.URL+"/stations/1", nil); status != http.StatusNotFound {
+		t.Errorf("Got status %d instead of the default 404", status)
+	}
+	if res, _ := http.Post(ts.URL+"/disabled", "application/json", nil); res.StatusCode != http.StatusNotFound {
+		t.Errorf("A disabled route should not be served")
+	}
+}
@@ -0,0 +1,151 @@
+// Use of this source code is governed by a MIT License.
+// License that can be found in the LICENSE file.
+
+// Package mockoon loads environments exported by Mockoon (https://mockoon.com) and serves them with net/http,
+// so that the mocks in the dev directory can be used with only the Go toolchain installed.
+//
+// The routes, the query and header based rules, the response bodies and files, the status codes, the headers
+// and the latency of the environment and of each response are supported. Of the templating helpers, now,
+// header and queryParam are supported. Proxy mode and https are not supported.
+//
+//	env, err := mockoon.Load("dev/opentransport-api-mocks.json")
+//	http.ListenAndServe(":3001", mockoon.NewHandler(env, mockoon.Options{}))
+package mockoon
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// A Mockoon environment with its routes
+type Environment struct {
+	Name           string   `json:"name"`
+	EndpointPrefix string   `json:"endpointPrefix"` // The prefix of all routes, e.g. v1.
+	Latency        int      `json:"latency"`        // The latency of all responses in milliseconds.
+	Port           int      `json:"port"`
+	CORS           bool     `json:"cors"` // Adds the CORS headers to all responses and answers preflight requests.
+	Headers        []Header `json:"headers"`
+	Routes         []Route  `json:"routes"`
+
+	// The directory of the environment file, which is the base of relative file paths
+	dir string
+}
+
+// A route of an environment
+type Route struct {
+	Documentation string     `json:"documentation"`
+	Method        string     `json:"method"`
+	Endpoint      string     `json:"endpoint"` // The path without the prefix. Segments starting with a colon are parameters.
+	Responses     []Response `json:"responses"`
+	Enabled       bool       `json:"enabled"`
+}
+
+// A possible response of a route. The first response is the default, if no rules of another response match.
+type Response struct {
+	Label          string     `json:"label"`
+	Body           string     `json:"body"`
+	Latency        int        `json:"latency"` // Additional latency in milliseconds.
+	StatusCode     StatusCode `json:"statusCode"`
+	Headers        []Header   `json:"headers"`
+	FilePath       string     `json:"filePath"`
+	SendFileAsBody bool       `json:"sendFileAsBody"`
+	Rules          []Rule     `json:"rules"`
+}
+
+// A header of a response. Headers with an empty key are ignored.
+type Header struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// A rule, which has to match for a response to be chosen
+type Rule struct {
+	Target   string `json:"target"`   // query, header, params or body
+	Modifier string `json:"modifier"` // The name of the query parameter, the header or the route parameter.
+	Value    string `json:"value"`
+	IsRegex  bool   `json:"isRegex"`
+}
+
+// A http status code, which is exported as string by older versions of Mockoon and as number by newer ones
+type StatusCode int
+
+// The export file, which contains one or more environments
+type export struct {
+	Source string `json:"source"`
+	Data   []struct {
+		Type string          `json:"type"`
+		Item json.RawMessage `json:"item"`
+	} `json:"data"`
+}
+
+// Loads the first environment of a Mockoon export or of a Mockoon environment file.
+//
+// Returns a pointer to an Environment and an error if the file can not be read or contains no environment
+func Load(filename string) (*Environment, error) {
+	raw, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read environment: %w", err)
+	}
+
+	env, err := Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s: %w", filename, err)
+	}
+	env.dir = filepath.Dir(filename)
+	return env, nil
+}
+
+// Parses the first environment of a Mockoon export or of a Mockoon environment file.
+// Relative file paths of the responses are resolved against the working directory.
+//
+// Returns a pointer to an Environment and an error if the data contains no environment
+func Parse(raw []byte) (*Environment, error) {
+	var exp export
+	if err := json.Unmarshal(raw, &exp); err != nil {
+		return nil, fmt.Errorf("invalid json: %w", err)
+	}
+
+	item := json.RawMessage(raw)
+	if len(exp.Data) > 0 {
+		item = nil
+		for _, d := range exp.Data {
+			if d.Type == "environment" {
+				item = d.Item
+				break
+			}
+		}
+		if item == nil {
+			return nil, errors.New("the export contains no environment")
+		}
+	}
+
+	var env Environment
+	if err := json.Unmarshal(item, &env); err != nil {
+		return nil, fmt.Errorf("invalid environment: %w", err)
+	}
+	if len(env.Routes) == 0 {
+		return nil, errors.New("the environment has no routes")
+	}
+	return &env, nil
+}
+
+// Parses a status code of a string or a number. An empty status code is 200.
+func (s *StatusCode) UnmarshalJSON(raw []byte) error {
+	v := strings.Trim(string(raw), `"`)
+	if v == "" || v == "null" {
+		*s = 200
+		return nil
+	}
+
+	code, err := strconv.Atoi(v)
+	if err != nil {
+		return fmt.Errorf("invalid status code %s", raw)
+	}
+	*s = StatusCode(code)
+	return nil
+}
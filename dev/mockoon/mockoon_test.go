@@ -0,0 +1,62 @@
+package mockoon
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	env, err := Load("../opentransport-api-mocks.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if env.Name != "Swiss Public Transport API" || env.EndpointPrefix != "v1" || env.Port != 3001 || env.Latency != 80 {
+		t.Errorf("The environment was not loaded: %+v", env)
+	}
+	if got := len(env.Routes); got != 5 {
+		t.Errorf("Got %d routes instead of 5", got)
+	}
+	if got := env.Routes[2].Responses[5]; got.Latency != 1400 || got.StatusCode != 200 || !got.Rules[2].IsRegex {
+		t.Errorf("The response was not loaded: %+v", got)
+	}
+
+	if _, err := Load("missing.json"); err == nil {
+		t.Errorf("A missing file should be an error")
+	}
+}
+
+func TestParse(t *testing.T) {
+	// An environment file of newer Mockoon versions with a numeric status code
+	env, err := Parse([]byte(`{"name":"plain","routes":[{"method":"get","endpoint":"a","enabled":true,"responses":[{"statusCode":404}]}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if env.Name != "plain" || env.Routes[0].Responses[0].StatusCode != 404 {
+		t.Errorf("The environment was not parsed: %+v", env)
+	}
+
+	for _, raw := range []string{
+		`{"source":"mockoon:1.7.0","data":[{"type":"route","item":{}}]}`,
+		`{"name":"empty","routes":[]}`,
+		`{"name":`,
+	} {
+		if _, err := Parse([]byte(raw)); err == nil {
+			t.Errorf("Parsing %s should fail", raw)
+		}
+	}
+}
+
+func TestStatusCode_UnmarshalJSON(t *testing.T) {
+	for raw, want := range map[string]StatusCode{`"201"`: 201, `429`: 429, `""`: 200, `null`: 200} {
+		var got StatusCode
+		if err := json.Unmarshal([]byte(raw), &got); err != nil || got != want {
+			t.Errorf("Got %d, %v for %s instead of %d", got, err, raw, want)
+		}
+	}
+
+	var s StatusCode
+	if err := json.Unmarshal([]byte(`"ok"`), &s); err == nil {
+		t.Errorf("An invalid status code should be an error")
+	}
+}
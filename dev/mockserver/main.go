@@ -0,0 +1,46 @@
+// The mockserver serves the Mockoon environment of the dev directory without Mockoon.
+// Run it from the root directory of the repository:
+//
+//	go run ./dev/mockserver
+//	go run ./dev/mockserver -env dev/opentransport-api-mocks.json -addr :3001 -no-latency
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/minderjan/opentransport-client/dev/mockoon"
+)
+
+func main() {
+	envFile := flag.String("env", "dev/opentransport-api-mocks.json", "the Mockoon environment or export file")
+	addr := flag.String("addr", "", "the listen address (default is the port of the environment)")
+	noLatency := flag.Bool("no-latency", false, "ignore the latency of the environment and the responses")
+	quiet := flag.Bool("quiet", false, "do not log the requests")
+	flag.Parse()
+
+	env, err := mockoon.Load(*envFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Could not load the mock environment: %s\n", err)
+		os.Exit(1)
+	}
+
+	if *addr == "" {
+		port := env.Port
+		if port == 0 {
+			port = 3001
+		}
+		*addr = fmt.Sprintf(":%d", port)
+	}
+
+	opts := mockoon.Options{DisableLatency: *noLatency}
+	if !*quiet {
+		opts.Logger = log.New(os.Stdout, "", log.Ltime)
+	}
+
+	log.Printf("Serving %q with %d routes on http://localhost%s/%s/", env.Name, len(env.Routes), *addr, env.EndpointPrefix)
+	log.Fatal(http.ListenAndServe(*addr, mockoon.NewHandler(env, opts)))
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/minderjan/opentransport-client/opentransport"
 	"io"
+	"log"
 	"os"
 )
 
@@ -33,4 +34,14 @@ func main() {
 	// Enable advanced logging
 	client.EnableLogs(multi)
 
+	// ------------- Structured Logs --------------------------
+
+	// Write info, warn and error logs as key=value pairs
+	logger := opentransport.NewStdLogger(log.New(os.Stdout, "", log.LstdFlags), opentransport.LevelInfo)
+
+	// Create a client with a custom logger
+	if _, err := opentransport.New(opentransport.WithLogger(logger)); err != nil {
+		fmt.Printf("Could not create the client %s", err)
+	}
+
 }
@@ -0,0 +1,246 @@
+package opentransport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Returned while the circuit breaker is open. Use errors.Is to check for it.
+var ErrCircuitOpen = errors.New("opentransport: circuit open")
+
+// The state of a circuit breaker
+type CircuitState int
+
+const (
+	// Requests are sent to the API.
+	CircuitClosed CircuitState = iota
+
+	// Requests fail immediately with a CircuitOpenError.
+	CircuitOpen
+
+	// A limited amount of trial requests is sent to the API to check if it recovered.
+	CircuitHalfOpen
+)
+
+// Configuration of a circuit breaker. Zero values are replaced by the defaults.
+type BreakerSettings struct {
+	FailureThreshold int                                      // Consecutive failed requests which open the circuit. Default is 5.
+	OpenTimeout      time.Duration                            // The time the circuit stays open before a trial request is sent. Default is 30 seconds.
+	HalfOpenRequests int                                      // Successful trial requests which close the circuit again. Default is 1.
+	IsFailure        func(err error) bool                     // Decides which errors count as failure. Defaults to network errors, HTTP 429 and server errors.
+	OnStateChange    func(from CircuitState, to CircuitState) // Called after every change of the state.
+}
+
+// A CircuitOpenError is returned if a request is rejected by an open circuit breaker.
+type CircuitOpenError struct {
+	RetryIn time.Duration // The time until the next trial request is allowed.
+}
+
+// A CircuitBreaker stops sending requests to the API after repeated failures. While the circuit is open,
+// requests fail immediately or are served by the cache or the offline store. After the open timeout, trial
+// requests decide if the circuit is closed again. It is safe for concurrent use and can be shared between clients.
+type CircuitBreaker struct {
+	mu        sync.Mutex
+	settings  BreakerSettings
+	state     CircuitState
+	failures  int
+	successes int
+	trials    int
+	openedAt  time.Time
+	now       func() time.Time
+}
+
+// A change of the circuit state
+type transition struct {
+	from    CircuitState
+	to      CircuitState
+	changed bool
+}
+
+// Returns the name of the state
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return fmt.Sprintf("state(%d)", int(s))
+}
+
+// Returns a description of the open circuit
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("opentransport: circuit open, next trial request possible in %s", e.RetryIn)
+}
+
+// Reports whether the target is ErrCircuitOpen
+func (e *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// Creates a new circuit breaker in the closed state.
+//
+// Returns a pointer to a CircuitBreaker
+func NewCircuitBreaker(settings BreakerSettings) *CircuitBreaker {
+	if settings.FailureThreshold <= 0 {
+		settings.FailureThreshold = 5
+	}
+	if settings.OpenTimeout <= 0 {
+		settings.OpenTimeout = 30 * time.Second
+	}
+	if settings.HalfOpenRequests <= 0 {
+		settings.HalfOpenRequests = 1
+	}
+	if settings.IsFailure == nil {
+		settings.IsFailure = breakerFailure
+	}
+	return &CircuitBreaker{settings: settings, now: time.Now}
+}
+
+// Sets a circuit breaker which protects all services. If the breaker is nil, the circuit breaker is disabled.
+func WithCircuitBreaker(breaker *CircuitBreaker) Option {
+	return func(cfg *clientConfig) error {
+		cfg.breaker = breaker
+		return nil
+	}
+}
+
+// Returns the current state of the circuit. An open circuit is reported as half-open as soon as trial requests are allowed.
+func (b *CircuitBreaker) State() CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == CircuitOpen && !b.now().Before(b.openedAt.Add(b.settings.OpenTimeout)) {
+		return CircuitHalfOpen
+	}
+	return b.state
+}
+
+// Checks if a request may be sent. An open circuit changes to half-open after the open timeout.
+//
+// Returns the change of the state and a CircuitOpenError if the request is rejected
+func (b *CircuitBreaker) allow() (t transition, err error) {
+	defer func() {
+		b.notify(t)
+	}()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == CircuitOpen {
+		retryIn := b.openedAt.Add(b.settings.OpenTimeout).Sub(b.now())
+		if retryIn > 0 {
+			return t, &CircuitOpenError{RetryIn: retryIn}
+		}
+		t = b.setState(CircuitHalfOpen)
+	}
+
+	if b.state == CircuitHalfOpen {
+		if b.trials >= b.settings.HalfOpenRequests {
+			return t, &CircuitOpenError{}
+		}
+		b.trials++
+	}
+	return t, nil
+}
+
+// Records the result of a request which was allowed before. The context is the one of the caller.
+//
+// Returns the change of the state
+func (b *CircuitBreaker) record(ctx context.Context, err error) (t transition) {
+	defer func() {
+		b.notify(t)
+	}()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == CircuitHalfOpen && b.trials > 0 {
+		b.trials--
+	}
+
+	// Requests of a caller who gave up and exceeded quotas say nothing about the API. A timeout
+	// of a single attempt does, e.g. if the API hangs.
+	if err != nil && (ctx.Err() != nil || errors.Is(err, context.Canceled) || errors.Is(err, ErrQuotaExceeded)) {
+		return transition{}
+	}
+
+	if err != nil && b.settings.IsFailure(err) {
+		b.successes = 0
+		b.failures++
+		if b.state == CircuitHalfOpen || (b.state == CircuitClosed && b.failures >= b.settings.FailureThreshold) {
+			return b.setState(CircuitOpen)
+		}
+		return transition{}
+	}
+
+	b.failures = 0
+	if b.state == CircuitHalfOpen {
+		b.successes++
+		if b.successes >= b.settings.HalfOpenRequests {
+			return b.setState(CircuitClosed)
+		}
+	}
+	return transition{}
+}
+
+// Changes the state and resets the counters. The lock has to be held by the caller.
+func (b *CircuitBreaker) setState(state CircuitState) transition {
+	t := transition{from: b.state, to: state, changed: b.state != state}
+	b.state = state
+	b.failures = 0
+	b.successes = 0
+	b.trials = 0
+	if state == CircuitOpen {
+		b.openedAt = b.now()
+	}
+	return t
+}
+
+// Calls the callback of the settings, if the state has changed. The lock must not be held by the caller.
+func (b *CircuitBreaker) notify(t transition) {
+	if t.changed && b.settings.OnStateChange != nil {
+		b.settings.OnStateChange(t.from, t.to)
+	}
+}
+
+// Reports a change of the circuit state to the logger and the metrics
+func (c *Client) observeTransition(cfg *clientConfig, t transition) {
+	if !t.changed {
+		return
+	}
+
+	level := LevelInfo
+	if t.to == CircuitOpen {
+		level = LevelWarn
+	}
+	cfg.logger.Log(level, "circuit state changed", "from", t.from.String(), "to", t.to.String())
+
+	if m, ok := cfg.metrics.(BreakerMetrics); ok {
+		m.ObserveBreakerState(t.to)
+	}
+}
+
+// Reports the current state of the circuit breaker to the metrics, so that the state is known before
+// the first change.
+func (cfg *clientConfig) observeBreakerState() {
+	if m, ok := cfg.metrics.(BreakerMetrics); ok && cfg.breaker != nil {
+		m.ObserveBreakerState(cfg.breaker.State())
+	}
+}
+
+// The default failure classification. Network errors, rate limit errors and server errors are failures.
+//
+// Returns true if the error counts as failure
+func breakerFailure(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Temporary()
+	}
+	return true
+}
@@ -0,0 +1,236 @@
+package opentransport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// Creates a circuit breaker with a fake clock, which can be moved with the returned function
+func newTestCircuitBreaker(settings BreakerSettings) (*CircuitBreaker, func(d time.Duration)) {
+	now := time.Now()
+	b := NewCircuitBreaker(settings)
+	b.now = func() time.Time { return now }
+	return b, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestCircuitBreaker_States(t *testing.T) {
+	var changes []string
+	b, advance := newTestCircuitBreaker(BreakerSettings{
+		FailureThreshold: 2,
+		OpenTimeout:      time.Minute,
+		OnStateChange: func(from CircuitState, to CircuitState) {
+			changes = append(changes, from.String()+">"+to.String())
+		},
+	})
+
+	serverErr := &APIError{StatusCode: http.StatusBadGateway}
+	notFound := &APIError{StatusCode: http.StatusNotFound}
+
+	// A client error resets the consecutive failures
+	for _, err := range []error{serverErr, notFound, serverErr} {
+		if _, err := b.allow(); err != nil {
+			t.Fatalf("A closed circuit should allow requests: %s", err)
+		}
+		b.record(context.Background(), err)
+	}
+
+	if got := b.State(); got != CircuitClosed {
+		t.Fatalf("The circuit is %s but want closed", got)
+	}
+
+	_, _ = b.allow()
+	if got := b.record(context.Background(), serverErr); !got.changed || got.to != CircuitOpen {
+		t.Fatalf("The second consecutive failure should open the circuit")
+	}
+
+	advance(20 * time.Second)
+	_, err := b.allow()
+	var openErr *CircuitOpenError
+	if !errors.As(err, &openErr) || !errors.Is(err, ErrCircuitOpen) || openErr.RetryIn != 40*time.Second {
+		t.Fatalf("An open circuit should reject requests with a CircuitOpenError but got %v", err)
+	}
+
+	// After the timeout, only one trial request is allowed
+	advance(40 * time.Second)
+	if got := b.State(); got != CircuitHalfOpen {
+		t.Errorf("The circuit is %s but want half-open", got)
+	}
+
+	if t1, err := b.allow(); err != nil || t1.to != CircuitHalfOpen {
+		t.Fatalf("A trial request should be allowed after the timeout: %v", err)
+	}
+
+	if _, err := b.allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("A second concurrent trial request should be rejected")
+	}
+
+	// A failed trial opens the circuit again, a successful trial closes it
+	b.record(context.Background(), serverErr)
+	advance(time.Minute)
+	_, _ = b.allow()
+	b.record(context.Background(), nil)
+
+	if got := b.State(); got != CircuitClosed {
+		t.Errorf("The circuit is %s but want closed", got)
+	}
+
+	want := "closed>open,open>half-open,half-open>open,open>half-open,half-open>closed"
+	if got := strings.Join(changes, ","); got != want {
+		t.Errorf("The circuit changed %s but want %s", got, want)
+	}
+}
+
+func TestCircuitBreaker_IgnoredErrors(t *testing.T) {
+	b, _ := newTestCircuitBreaker(BreakerSettings{FailureThreshold: 1})
+
+	expired, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for _, err := range []error{context.Canceled, &QuotaError{Limit: "day"}} {
+		_, _ = b.allow()
+		b.record(context.Background(), err)
+	}
+
+	// The caller gave up
+	_, _ = b.allow()
+	b.record(expired, &APIError{Err: context.DeadlineExceeded})
+
+	if got := b.State(); got != CircuitClosed {
+		t.Errorf("Canceled requests and exceeded quotas should not open the circuit but it is %s", got)
+	}
+
+	// A timeout of an attempt of a waiting caller is a failure
+	_, _ = b.allow()
+	b.record(context.Background(), &APIError{Err: context.DeadlineExceeded})
+
+	if got := b.State(); got != CircuitOpen {
+		t.Errorf("A timed out attempt should open the circuit but it is %s", got)
+	}
+}
+
+func TestClient_CircuitBreaker(t *testing.T) {
+	mux, client, teardown := prepare()
+	defer teardown()
+
+	var calls int32
+	mux.HandleFunc("/locations", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	metrics := NewPrometheusMetrics()
+	client = mustWith(t, client,
+		WithMetrics(metrics),
+		WithCircuitBreaker(NewCircuitBreaker(BreakerSettings{FailureThreshold: 2, OpenTimeout: time.Hour})),
+		WithRetryPolicy(ConstantBackoff{Attempts: 3, Pause: time.Millisecond}),
+	)
+
+	// The circuit opens during the retries and stops them
+	if _, err := client.Location.Search(context.Background(), "Bern"); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("The request should fail with an open circuit but got %v", err)
+	}
+
+	start := time.Now()
+	if _, err := client.Location.Search(context.Background(), "Bern"); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("The request should fail fast with an open circuit but got %v", err)
+	}
+
+	if time.Since(start) > 100*time.Millisecond {
+		t.Errorf("An open circuit should fail fast")
+	}
+
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Errorf("The api was queried %d times but want 2", got)
+	}
+
+	var out strings.Builder
+	_ = metrics.WritePrometheus(&out)
+	if !strings.Contains(out.String(), `opentransport_circuit_state{state="open"} 1`) {
+		t.Errorf("The open circuit should be exposed as metric")
+	}
+}
+
+func TestClient_CircuitBreakerStaleCache(t *testing.T) {
+	mux, client, teardown := prepare()
+	defer teardown()
+
+	var fail int32
+	mux.HandleFunc("/locations", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&fail) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"stations":[{"name":"Bern"}]}`))
+	})
+
+	client = mustWith(t, client,
+		WithCache(NewLRUCache(10), CacheTTL{ServiceLocation: time.Nanosecond}),
+		WithCircuitBreaker(NewCircuitBreaker(BreakerSettings{FailureThreshold: 1, OpenTimeout: time.Hour})),
+		WithRetryPolicy(NoRetry),
+	)
+
+	if _, err := client.Location.Search(context.Background(), "Bern"); err != nil {
+		t.Fatalf("Failed to search a location: %s", err)
+	}
+
+	atomic.StoreInt32(&fail, 1)
+	if _, err := client.Location.Search(context.Background(), "Bern"); !errors.Is(err, ErrServerError) {
+		t.Fatalf("The request should fail with a server error but got %v", err)
+	}
+
+	// The circuit is open now and the expired response is served
+	locations, err := client.Location.Search(context.Background(), "Bern")
+	if err != nil {
+		t.Fatalf("The expired response should be served while the circuit is open: %s", err)
+	}
+
+	if len(locations) != 1 || locations[0].Name != "Bern" {
+		t.Errorf("The cached response should be returned but got %+v", locations)
+	}
+}
+
+func TestClient_CircuitBreakerTimeout(t *testing.T) {
+	mux, client, teardown := prepare()
+	defer teardown()
+
+	var calls int32
+	mux.HandleFunc("/locations", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		<-r.Context().Done()
+	})
+
+	c, err := client.With(
+		WithTimeout(20*time.Millisecond),
+		WithRetryPolicy(ConstantBackoff{Attempts: 3, Pause: time.Millisecond}),
+		WithCircuitBreaker(NewCircuitBreaker(BreakerSettings{FailureThreshold: 2, OpenTimeout: time.Hour})),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A hanging API opens the circuit
+	if _, err := c.Location.Search(context.Background(), "Bern"); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("The request should fail with an open circuit but got %v", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Errorf("The api was queried %d times but want 2", got)
+	}
+}
+
+func TestClient_CircuitBreakerInitialState(t *testing.T) {
+	metrics := NewPrometheusMetrics()
+	if _, err := New(WithMetrics(metrics), WithCircuitBreaker(NewCircuitBreaker(BreakerSettings{}))); err != nil {
+		t.Fatal(err)
+	}
+
+	var out strings.Builder
+	_ = metrics.WritePrometheus(&out)
+	if !strings.Contains(out.String(), `opentransport_circuit_state{state="closed"} 1`) {
+		t.Errorf("The closed circuit should be exposed before the first change")
+	}
+}
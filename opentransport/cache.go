@@ -0,0 +1,193 @@
+package opentransport
+
+import (
+	"container/list"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Service identifies the part of the API a request belongs to.
+type Service string
+
+const (
+	ServiceLocation     Service = "location"
+	ServiceConnection   Service = "connection"
+	ServiceStationboard Service = "stationboard"
+	ServiceInfo         Service = "info"
+)
+
+// A Cache stores raw responses of the API. Implementations have to be safe for concurrent use.
+// Entries which are expired should not be returned by Get.
+type Cache interface {
+	// Returns the entry stored for the key and true, or false if there is no valid entry.
+	Get(key string) (CacheEntry, bool)
+
+	// Stores an entry for the key.
+	Set(key string, entry CacheEntry)
+}
+
+// A StaleCache can return expired entries. While the circuit breaker is open, expired entries
+// are served instead of an error. LRUCache implements this interface.
+type StaleCache interface {
+	Cache
+
+	// Returns the entry stored for the key and true, even if it is expired.
+	GetStale(key string) (CacheEntry, bool)
+}
+
+// A raw response of the API stored in a cache.
+type CacheEntry struct {
+	Body     []byte    // The raw response body.
+	StoredAt time.Time // The time the response was received from the API.
+	Expires  time.Time // The time the entry becomes invalid.
+}
+
+// The time to live of cached responses per service. Services without a positive TTL are not cached.
+type CacheTTL map[Service]time.Duration
+
+// Default time to live values. Locations barely change, connections and stationboards contain realtime data.
+var DefaultCacheTTL = CacheTTL{
+	ServiceLocation:     72 * time.Hour,
+	ServiceConnection:   time.Minute,
+	ServiceStationboard: 15 * time.Second,
+}
+
+// An in-memory cache, which evicts the least recently used entry when the capacity is reached.
+type LRUCache struct {
+	mu       sync.Mutex
+	capacity int
+	items    map[string]*list.Element
+	order    *list.List
+	now      func() time.Time
+}
+
+// An item of the lru list
+type lruItem struct {
+	key   string
+	entry CacheEntry
+}
+
+// Creates a new in-memory lru cache with a maximum amount of entries.
+// A capacity less than 1 will be set to 1.
+//
+// Returns a pointer to a LRUCache
+func NewLRUCache(capacity int) *LRUCache {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &LRUCache{
+		capacity: capacity,
+		items:    make(map[string]*list.Element),
+		order:    list.New(),
+		now:      time.Now,
+	}
+}
+
+// Returns the entry stored for the key. Expired entries are not returned, but kept for GetStale
+// until they are replaced or evicted.
+func (c *LRUCache) Get(key string) (CacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return CacheEntry{}, false
+	}
+
+	item := el.Value.(*lruItem)
+	if !c.now().Before(item.entry.Expires) {
+		return CacheEntry{}, false
+	}
+
+	c.order.MoveToFront(el)
+	return item.entry, true
+}
+
+// Returns the entry stored for the key, even if it is expired.
+func (c *LRUCache) GetStale(key string) (CacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return CacheEntry{}, false
+	}
+	return el.Value.(*lruItem).entry, true
+}
+
+// Stores an entry and evicts the least recently used entry if the capacity is reached.
+func (c *LRUCache) Set(key string, entry CacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		el.Value.(*lruItem).entry = entry
+		c.order.MoveToFront(el)
+		return
+	}
+
+	c.items[key] = c.order.PushFront(&lruItem{key: key, entry: entry})
+
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruItem).key)
+	}
+}
+
+// Returns the amount of entries in the cache, including expired entries which were not yet evicted.
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// Creates a cache key based on the request path relative to the api url. The query parameters
+// are sorted, so that the same query results in the same key.
+//
+// Returns the normalized path and the service of the request
+func (cfg *clientConfig) cacheKey(req *http.Request) (string, Service) {
+	path := req.URL.Path
+	base := cfg.apiUrl
+	if req.URL.Host == base.Host && strings.HasPrefix(path, base.Path) {
+		path = strings.TrimPrefix(path, base.Path)
+	} else {
+		path = req.URL.Scheme + "://" + req.URL.Host + path
+	}
+
+	key := path
+	if query, err := url.ParseQuery(req.URL.RawQuery); err == nil && len(query) > 0 {
+		key = path + "?" + query.Encode()
+	} else if len(req.URL.RawQuery) > 0 {
+		key = path + "?" + req.URL.RawQuery
+	}
+
+	return key, serviceOf(path)
+}
+
+// Returns the cache and the time to live of a service, or a nil cache if responses of the service are not cached
+func (cfg *clientConfig) cacheFor(service Service) (Cache, time.Duration) {
+	ttl := cfg.cacheTTL[service]
+	if cfg.cache == nil || ttl <= 0 {
+		return nil, 0
+	}
+	return cfg.cache, ttl
+}
+
+// Returns the service of a relative request path or an empty service if it is unknown
+func serviceOf(path string) Service {
+	switch strings.SplitN(path, "?", 2)[0] {
+	case "locations":
+		return ServiceLocation
+	case "connections":
+		return ServiceConnection
+	case "stationboard":
+		return ServiceStationboard
+	case "":
+		return ServiceInfo
+	}
+	return ""
+}
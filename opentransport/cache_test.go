@@ -0,0 +1,151 @@
+package opentransport
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLRUCache_Evict(t *testing.T) {
+	c := NewLRUCache(2)
+	expires := time.Now().Add(time.Hour)
+
+	c.Set("a", CacheEntry{Body: []byte("a"), Expires: expires})
+	c.Set("b", CacheEntry{Body: []byte("b"), Expires: expires})
+
+	// Use a, so that b is the least recently used entry
+	if _, ok := c.Get("a"); !ok {
+		t.Errorf("The entry a should be cached")
+	}
+
+	c.Set("c", CacheEntry{Body: []byte("c"), Expires: expires})
+
+	if _, ok := c.Get("b"); ok {
+		t.Errorf("The least recently used entry b should be evicted")
+	}
+
+	if got, want := c.Len(), 2; got != want {
+		t.Errorf("The cache contains %d entries but want %d", got, want)
+	}
+}
+
+func TestLRUCache_Expired(t *testing.T) {
+	now := time.Now()
+	c := NewLRUCache(10)
+	c.now = func() time.Time { return now }
+
+	c.Set("a", CacheEntry{Body: []byte("a"), Expires: now.Add(time.Second)})
+
+	if _, ok := c.Get("a"); !ok {
+		t.Errorf("A valid entry should be returned")
+	}
+
+	now = now.Add(time.Second)
+	if _, ok := c.Get("a"); ok {
+		t.Errorf("An expired entry should not be returned")
+	}
+
+	// Expired entries are kept for an open circuit breaker
+	if entry, ok := c.GetStale("a"); !ok || string(entry.Body) != "a" {
+		t.Errorf("An expired entry should be returned by GetStale")
+	}
+
+	if _, ok := c.GetStale("b"); ok {
+		t.Errorf("A missing entry should not be returned by GetStale")
+	}
+}
+
+func TestClient_cacheKey(t *testing.T) {
+	client, _ := NewClientWithUrl(nil, "https://transport.opendata.ch/v1")
+
+	testValues := []struct {
+		path    string
+		key     string
+		service Service
+	}{
+		{"stationboard?station=Z%C3%BCrich&limit=3&type=departure", "stationboard?limit=3&station=Z%C3%BCrich&type=departure", ServiceStationboard},
+		{"locations?type=all&query=Bern", "locations?query=Bern&type=all", ServiceLocation},
+		{"connections?from=A&to=B&via[]=C&via[]=D", "connections?from=A&to=B&via%5B%5D=C&via%5B%5D=D", ServiceConnection},
+		{"", "", ServiceInfo},
+		{"unknown", "unknown", ""},
+	}
+
+	for _, tv := range testValues {
+		req, err := client.NewRequest(context.Background(), tv.path)
+		if err != nil {
+			t.Fatalf("Failed to create request: %s", err)
+		}
+
+		key, service := client.cfg.cacheKey(req)
+		if key != tv.key || service != tv.service {
+			t.Errorf("The path %s has the cache key (%s, %s) but want (%s, %s)", tv.path, key, service, tv.key, tv.service)
+		}
+	}
+}
+
+func TestClient_CacheTTL(t *testing.T) {
+	srv, client, terminate := prepare()
+	defer terminate()
+
+	var stbCalls, locCalls int32
+	srv.HandleFunc("/stationboard", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&stbCalls, 1)
+		_, _ = fmt.Fprint(w, `{"stationboard":[]}`)
+	})
+	srv.HandleFunc("/locations", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&locCalls, 1)
+		_, _ = fmt.Fprint(w, `{"stations":[]}`)
+	})
+
+	var logs bytes.Buffer
+	client.EnableLogs(&logs)
+	client = mustWith(t, client, WithCache(NewLRUCache(10), CacheTTL{ServiceLocation: time.Hour}))
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.Location.Search(context.Background(), "Zürich"); err != nil {
+			t.Errorf("Failed to search location: %s", err)
+		}
+		if _, err := client.Stationboard.SearchWithDate(context.Background(), "Zürich", time.Now()); err != nil {
+			t.Errorf("Failed to search stationboard: %s", err)
+		}
+	}
+
+	if got, want := atomic.LoadInt32(&locCalls), int32(1); got != want {
+		t.Errorf("The location service queried the api %d times but want %d", got, want)
+	}
+
+	// The stationboard service has no ttl and is not cached
+	if got, want := atomic.LoadInt32(&stbCalls), int32(3); got != want {
+		t.Errorf("The stationboard service queried the api %d times but want %d", got, want)
+	}
+
+	if got := logs.String(); !strings.Contains(got, `msg="cache hit"`) || !strings.Contains(got, `msg="cache miss"`) {
+		t.Errorf("Cache hits and misses should be logged")
+	}
+}
+
+func TestClient_CacheErrorsNotStored(t *testing.T) {
+	srv, client, terminate := prepare()
+	defer terminate()
+
+	var calls int32
+	srv.HandleFunc("/locations", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	client = mustWith(t, client, WithCache(NewLRUCache(10), nil))
+
+	for i := 0; i < 2; i++ {
+		_, _ = client.Location.Search(context.Background(), "Zürich")
+	}
+
+	if got, want := atomic.LoadInt32(&calls), int32(2); got != want {
+		t.Errorf("Failed requests should not be cached: %d calls but want %d", got, want)
+	}
+}
@@ -0,0 +1,119 @@
+package opentransport
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Tracks the requests which are currently in flight, so that concurrent identical requests share one call
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flight
+}
+
+// A shared call and the amount of callers which are waiting for its result
+type flight struct {
+	done    chan struct{}
+	res     *response
+	err     error
+	waiters int
+	cancel  context.CancelFunc
+}
+
+// A context which keeps the values of its parent, but is never canceled by it
+type detachedContext struct {
+	parent context.Context
+}
+
+// Enables the coalescing of identical concurrent requests. Concurrent requests with the same url share
+// one http request and every caller gets its own copy of the response. A canceled caller stops waiting,
+// the shared request is only canceled if all callers are gone.
+func WithCoalescing() Option {
+	return func(cfg *clientConfig) error {
+		cfg.coalesce = true
+		return nil
+	}
+}
+
+// Creates an empty flight group
+func newFlightGroup() *flightGroup {
+	return &flightGroup{calls: make(map[string]*flight)}
+}
+
+// Calls fn once for all concurrent callers of the same key. The function receives a context, which is
+// detached from the callers and canceled as soon as the last waiting caller is gone.
+//
+// Returns a copy of the shared response or the error of the call or the context of the caller
+func (g *flightGroup) do(ctx context.Context, key string, fn func(ctx context.Context) (*response, error)) (*response, error) {
+	g.mu.Lock()
+	if f, ok := g.calls[key]; ok {
+		f.waiters++
+		g.mu.Unlock()
+		return g.wait(ctx, key, f)
+	}
+
+	fctx, cancel := context.WithCancel(detachedContext{parent: ctx})
+	f := &flight{done: make(chan struct{}), waiters: 1, cancel: cancel}
+	g.calls[key] = f
+	g.mu.Unlock()
+
+	go func() {
+		res, err := fn(fctx)
+
+		g.mu.Lock()
+		if g.calls[key] == f {
+			delete(g.calls, key)
+		}
+		g.mu.Unlock()
+
+		f.res, f.err = res, err
+		cancel()
+		close(f.done)
+	}()
+
+	return g.wait(ctx, key, f)
+}
+
+// Waits for the result of a flight or the cancellation of the caller
+func (g *flightGroup) wait(ctx context.Context, key string, f *flight) (*response, error) {
+	select {
+	case <-f.done:
+		if f.err != nil {
+			return nil, f.err
+		}
+		return &response{body: copyBytes(f.res.body), fetchedAt: f.res.fetchedAt, stale: f.res.stale}, nil
+	case <-ctx.Done():
+		g.mu.Lock()
+		f.waiters--
+		if f.waiters == 0 {
+			// Nobody waits for the result anymore, new callers start a new flight
+			f.cancel()
+			if g.calls[key] == f {
+				delete(g.calls, key)
+			}
+		}
+		g.mu.Unlock()
+		return nil, ctx.Err()
+	}
+}
+
+// Returns no deadline
+func (detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+// Returns nil, the context is never canceled
+func (detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+// Returns nil, the context is never canceled
+func (detachedContext) Err() error {
+	return nil
+}
+
+// Returns the value of the parent context
+func (c detachedContext) Value(key interface{}) interface{} {
+	return c.parent.Value(key)
+}
@@ -0,0 +1,162 @@
+package opentransport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// Waits until the amount of callers wait for the flight of the url
+func waitForFlight(t *testing.T, client *Client, url string, waiters int) {
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		client.flights.mu.Lock()
+		f, ok := client.flights.calls[url]
+		ready := ok && f.waiters == waiters
+		client.flights.mu.Unlock()
+
+		if ready {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("The flight of %s has not %d waiters", url, waiters)
+}
+
+// Prepares a stationboard endpoint which blocks until release is closed
+func prepareCoalescing(t *testing.T) (*Client, *int32, chan struct{}, <-chan struct{}, func()) {
+	mux, client, teardown := prepare()
+	fixture, _ := readFixture("stationboard_search")
+
+	var calls int32
+	release := make(chan struct{})
+	canceled := make(chan struct{})
+	mux.HandleFunc("/stationboard", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		select {
+		case <-release:
+			_, _ = w.Write(fixture)
+		case <-r.Context().Done():
+			close(canceled)
+		}
+	})
+
+	client = mustWith(t, client, WithCoalescing(), WithRetryPolicy(NoRetry))
+	return client, &calls, release, canceled, teardown
+}
+
+func TestClient_Coalesce(t *testing.T) {
+	client, calls, release, _, teardown := prepareCoalescing(t)
+	defer teardown()
+
+	opts := StbOpts{DateTime: time.Date(2020, 5, 2, 20, 0, 0, 0, time.UTC), Limit: 15}
+	req, _ := client.NewRequest(context.Background(), mustPath(t, client, "Bern", opts))
+
+	const callers = 10
+	results := make([]*StationboardResult, callers)
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			result, err := client.Stationboard.SearchWithOpts(context.Background(), "Bern", opts)
+			if err != nil {
+				t.Errorf("The caller %d failed: %s", i, err)
+			}
+			results[i] = result
+		}(i)
+	}
+
+	waitForFlight(t, client, req.URL.String(), callers)
+	close(release)
+	wg.Wait()
+
+	if got := atomic.LoadInt32(calls); got != 1 {
+		t.Errorf("The api was queried %d times but want 1", got)
+	}
+
+	// Every caller gets its own copy of the result
+	if results[0] == nil || results[1] == nil || results[0] == results[1] || len(results[0].Journeys) == 0 {
+		t.Fatalf("Every caller should get its own result")
+	}
+	results[0].Journeys[0].Name = "changed"
+	if results[1].Journeys[0].Name == "changed" {
+		t.Errorf("A change of one result should not affect another")
+	}
+}
+
+func TestClient_CoalesceCancel(t *testing.T) {
+	client, calls, release, canceled, teardown := prepareCoalescing(t)
+	defer teardown()
+
+	opts := StbOpts{DateTime: time.Date(2020, 5, 2, 20, 0, 0, 0, time.UTC), Limit: 15}
+	req, _ := client.NewRequest(context.Background(), mustPath(t, client, "Bern", opts))
+
+	// The first caller cancels, the second caller still gets the result
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error, 1)
+	second := make(chan error, 1)
+	go func() {
+		_, err := client.Stationboard.SearchWithOpts(ctx, "Bern", opts)
+		first <- err
+	}()
+	waitForFlight(t, client, req.URL.String(), 1)
+
+	go func() {
+		_, err := client.Stationboard.SearchWithOpts(context.Background(), "Bern", opts)
+		second <- err
+	}()
+	waitForFlight(t, client, req.URL.String(), 2)
+
+	cancel()
+	if err := <-first; !errors.Is(err, context.Canceled) {
+		t.Errorf("The canceled caller should fail with context.Canceled but got %v", err)
+	}
+
+	close(release)
+	if err := <-second; err != nil {
+		t.Errorf("The second caller should not be affected by the cancellation: %s", err)
+	}
+
+	if got := atomic.LoadInt32(calls); got != 1 {
+		t.Errorf("The api was queried %d times but want 1", got)
+	}
+
+	select {
+	case <-canceled:
+		t.Errorf("The shared request should not be canceled while a caller is waiting")
+	default:
+	}
+}
+
+func TestClient_CoalesceCancelAll(t *testing.T) {
+	client, _, _, canceled, teardown := prepareCoalescing(t)
+	defer teardown()
+
+	opts := StbOpts{DateTime: time.Date(2020, 5, 2, 20, 0, 0, 0, time.UTC), Limit: 15}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := client.Stationboard.SearchWithOpts(ctx, "Bern", opts); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("The caller should fail with context.DeadlineExceeded but got %v", err)
+	}
+
+	select {
+	case <-canceled:
+	case <-time.After(5 * time.Second):
+		t.Errorf("The shared request should be canceled if no caller is waiting")
+	}
+}
+
+// Returns the path of a stationboard request
+func mustPath(t *testing.T, client *Client, name string, opts StbOpts) string {
+	path, err := client.Stationboard.buildUrlPath(name, opts)
+	if err != nil {
+		t.Fatalf("Failed to build the path: %s", err)
+	}
+	return path
+}
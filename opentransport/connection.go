@@ -17,8 +17,8 @@ type Connection struct {
 	Transfers   int            `json:"transfers"`   // Count of different vehicles.
 	Service     ServiceDetails `json:"service"`     // Service information about how regular the connection operates.
 	Products    []string       `json:"products"`    // List of transport products (e.g. IR, S9).
-	Capacity1st int            `json:"capacity1st"` // The maximum estimated occupation load of 1st class coaches (e.g. 1).
-	Capacity2nd int            `json:"capacity2nd"` // The maximum estimated occupation load of 2nd class coaches (e.g. 2).
+	Capacity1st OptionalInt    `json:"capacity1st"` // The maximum estimated occupation load of 1st class coaches (e.g. 1). Unknown if no prognosis is available.
+	Capacity2nd OptionalInt    `json:"capacity2nd"` // The maximum estimated occupation load of 2nd class coaches (e.g. 2). Unknown if no prognosis is available.
 	Sections    []Section      `json:"sections"`    // A list of sections.
 }
 
@@ -30,21 +30,21 @@ type ServiceDetails struct {
 
 // A checkpoint represents an arrival or a departure point (in time and space) of a connection.
 type Stop struct {
-	Station   Location  `json:"station"`   // A location object showing this line's stop at the requested station.
-	Arrival   isoDate   `json:"arrival"`   // The arrival time to the checkpoint. If the value is null, 0001-01-01 00:00:00 +0000 UTC will be returned.
-	Departure isoDate   `json:"departure"` // The departure time from the checkpoint. If the value is null, 0001-01-01 00:00:00 +0000 UTC will be returned.
-	Delay     int       `json:"delay"`     // The delay at this checkpoint, can be null if no prognosis is available.
-	Platform  string    `json:"platform"`  // The arrival/departure platform
-	Prognosis Prognosis `json:"prognosis"` // status of a connection checkpoint in realtime
+	Station   Location    `json:"station"`   // A location object showing this line's stop at the requested station.
+	Arrival   Timestamp   `json:"arrival"`   // The arrival time to the checkpoint. If the value is null, the timestamp is zero.
+	Departure Timestamp   `json:"departure"` // The departure time from the checkpoint. If the value is null, the timestamp is zero.
+	Delay     OptionalInt `json:"delay"`     // The delay at this checkpoint in minutes. Unknown if no realtime data is available, which is not the same as on time.
+	Platform  string      `json:"platform"`  // The arrival/departure platform
+	Prognosis Prognosis   `json:"prognosis"` // status of a connection checkpoint in realtime
 }
 
 // A prognosis contains "realtime" information on the status of a connection checkpoint.
 type Prognosis struct {
-	Platform    string  `json:"platform"`    // The estimated arrival/departure platform (e.g. 8). Can be empty if no platform is available for this connection.
-	Arrival     isoDate `json:"arrival"`     // The arrival time prognosis to the checkpoint, date format ISO 8601 (e.g. 2019-03-31T08:58:00+02:00).
-	Departure   isoDate `json:"departure"`   // The departure time prognosis to the checkpoint,  date format ISO 8601 (e.g. 2019-03-31T08:58:00+02:00).
-	Capacity1st int     `json:"capacity1st"` // The estimated occupation load of 1st class coaches (e.g. 1).
-	Capacity2nd int     `json:"capacity2nd"` // The estimated occupation load of 2nd class coaches (e.g. 2).
+	Platform    string      `json:"platform"`    // The estimated arrival/departure platform (e.g. 8). Can be empty if no platform is available for this connection.
+	Arrival     Timestamp   `json:"arrival"`     // The arrival time prognosis to the checkpoint, date format ISO 8601 (e.g. 2019-03-31T08:58:00+02:00).
+	Departure   Timestamp   `json:"departure"`   // The departure time prognosis to the checkpoint,  date format ISO 8601 (e.g. 2019-03-31T08:58:00+02:00).
+	Capacity1st OptionalInt `json:"capacity1st"` // The estimated occupation load of 1st class coaches (e.g. 1). Unknown if no prognosis is available.
+	Capacity2nd OptionalInt `json:"capacity2nd"` // The estimated occupation load of 2nd class coaches (e.g. 2). Unknown if no prognosis is available.
 }
 
 // A connection consists of one or multiple sections.
@@ -57,16 +57,16 @@ type Section struct {
 
 // The actual transportation of a section, e.g. a bus or a train between two stations.
 type Journey struct {
-	Name         string `json:"name"`         // The name of the connection (e.g. ICN 518).
-	Category     string `json:"category"`     // The type of connection this is (e.g. ICN).
-	Subcategory  string `json:"subcategory"`  // The sub type of connection this is (e.g. ICN).
-	CategoryCode int    `json:"categoryCode"` // Currently not available: https://github.com/OpendataCH/Transport/issues/160
-	Number       string `json:"number"`       // The number of the connection's line (e.g. 518).
-	Operator     string `json:"operator"`     // The operator of the connection's line (e.g. ZVV).
-	To           string `json:"to"`           // The final destination of this line (e.g. Zürich HB)
-	PassList     []Stop `json:"passList"`     // Checkpoints the train passed on the journey.
-	Capacity1st  int    `json:"capacity1st"`  // currently not available: https://github.com/OpendataCH/Transport/issues/163
-	Capacity2nd  int    `json:"capacity2nd"`  // currently not available: https://github.com/OpendataCH/Transport/issues/163
+	Name         string      `json:"name"`         // The name of the connection (e.g. ICN 518).
+	Category     string      `json:"category"`     // The type of connection this is (e.g. ICN).
+	Subcategory  string      `json:"subcategory"`  // The sub type of connection this is (e.g. ICN).
+	CategoryCode OptionalInt `json:"categoryCode"` // Currently not available: https://github.com/OpendataCH/Transport/issues/160
+	Number       string      `json:"number"`       // The number of the connection's line (e.g. 518).
+	Operator     string      `json:"operator"`     // The operator of the connection's line (e.g. ZVV).
+	To           string      `json:"to"`           // The final destination of this line (e.g. Zürich HB)
+	PassList     []Stop      `json:"passList"`     // Checkpoints the train passed on the journey.
+	Capacity1st  OptionalInt `json:"capacity1st"`  // currently not available: https://github.com/OpendataCH/Transport/issues/163
+	Capacity2nd  OptionalInt `json:"capacity2nd"`  // currently not available: https://github.com/OpendataCH/Transport/issues/163
 }
 
 // Information about walking distance, if available
@@ -83,6 +83,8 @@ type ConnectionResult struct {
 		From []Location `json:"from"` // Specifies the departure station of the connection.
 		To   []Location `json:"to"`   // Specifies the arrival station of the connection.
 	}
+	Stale     bool      `json:"-"` // True if the result was served by the offline store, because the API was not available.
+	FetchedAt time.Time `json:"-"` // The time the result was received from the API.
 }
 
 // Provides access to query connections
@@ -101,6 +103,7 @@ type ConnOpts struct {
 	Direct          bool             // defaults to false, if set to true only direct connections are allowed
 	Accessibility   Accessibility    // default is empty. You can set IndependentBoarding, AssistedBoarding or AdvancedNotice
 	Limit           int              // 1 - 16. Specifies the number of connections to return. If several connections depart at the same time they are counted as 1. Default limit is 0 which means, no limit is set.
+	Fields          []Field          // Limits the attributes of the response, e.g. ConnectionsFromDeparture. Default are all attributes.
 }
 
 type Accessibility string
@@ -160,11 +163,58 @@ func (s *ConnectionService) SearchWithOpts(ctx context.Context, from string, to
 		return nil, err
 	}
 
-	return s.query(ctx, path)
+	return s.query(ctx, path, s.spanAttributes(from, to, opts)...)
 }
 
-// Runs a connection query and returns a ConnectionResult struct
-func (s *ConnectionService) query(ctx context.Context, path string) (*ConnectionResult, error) {
+// Search for the next connections like SearchWithOpts, but decodes the connections lazily while iterating
+// over them. The size of the response can be limited with WithMaxResponseSize. Streamed responses are not cached,
+// not saved to the offline store and do not pass the middlewares. The iterator has to be closed after use.
+//
+// Returns a ConnectionIterator and an error if the request failed.
+func (s *ConnectionService) Stream(ctx context.Context, from string, to string, date time.Time, opts *ConnOpts) (it *ConnectionIterator, err error) {
+	d, t, err := s.formatDate(date)
+	if err != nil {
+		return nil, fmt.Errorf("bad input parameter: %w", err)
+	}
+
+	path, err := s.buildUrlPath(from, to, d, t, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, span := s.client.startSpan(ctx, SpanConnectionSearch, append(s.spanAttributes(from, to, opts), Attr(AttrStream, true))...)
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	body, err := s.client.openStream(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return NewConnectionIterator(body), nil
+}
+
+// Returns the span attributes of a connection search
+func (s *ConnectionService) spanAttributes(from string, to string, opts *ConnOpts) []Attribute {
+	return []Attribute{
+		Attr(AttrFrom, from),
+		Attr(AttrTo, to),
+		Attr(AttrViaCount, len(opts.Via)),
+		Attr(AttrLimit, opts.Limit),
+		Attr(AttrTransportations, convSlice(opts.Transportations)),
+	}
+}
+
+// Runs a connection query within a span with the attributes and returns a ConnectionResult struct
+func (s *ConnectionService) query(ctx context.Context, path string, attrs ...Attribute) (result *ConnectionResult, err error) {
+	ctx, span := s.client.startSpan(ctx, SpanConnectionSearch, attrs...)
+	defer func() {
+		if result != nil {
+			span.SetAttributes(Attr(AttrResultCount, len(result.Connections)), Attr(AttrStale, result.Stale))
+		}
+		endSpan(span, err)
+	}()
+
 	if len(path) == 0 {
 		return nil, errors.New("the request path can not be empty")
 	}
@@ -174,12 +224,19 @@ func (s *ConnectionService) query(ctx context.Context, path string) (*Connection
 		return nil, err
 	}
 
-	res, err := s.client.Do(req)
+	res, err := s.client.do(req)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.parseResponse(res)
+	connResult, err := s.parseResponse(res.body)
+	if err != nil {
+		return nil, err
+	}
+
+	connResult.Stale = res.stale
+	connResult.FetchedAt = res.fetchedAt
+	return connResult, nil
 }
 
 // Generates a formatted and url encoded path out of the provided parameters.
@@ -196,8 +253,13 @@ func (s *ConnectionService) buildUrlPath(from string, to string, date connDate,
 		return "", err
 	}
 
+	fields, err := convFields(opts.Fields, ConnectionResult{})
+	if err != nil {
+		return "", err
+	}
+
 	// build the request url path
-	path := fmt.Sprintf("connections?from=%s&to=%s&date=%s&time=%s&isArrivalTime=%d&direct=%d&bike=%d&sleeper=%d&couchette=%d%s%s&limit=%d",
+	path := fmt.Sprintf("connections?from=%s&to=%s&date=%s&time=%s&isArrivalTime=%d&direct=%d&bike=%d&sleeper=%d&couchette=%d%s%s&limit=%d%s",
 		url.PathEscape(from),
 		url.PathEscape(to),
 		url.PathEscape(string(date)),
@@ -210,6 +272,7 @@ func (s *ConnectionService) buildUrlPath(from string, to string, date connDate,
 		via,
 		transportations,
 		opts.Limit,
+		fields,
 	)
 
 	return path, nil
@@ -229,11 +292,12 @@ func (s *ConnectionService) parseResponse(raw []byte) (*ConnectionResult, error)
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
 
-	s.client.debug.Printf("Parsed connection response with %d bytes to a structured type", len(raw))
+	s.client.log(LevelDebug, "parsed connection response", "bytes", len(raw))
 	return &conResp, err
 }
 
-// Parse a time.Time Date / Time in a date string with a format, accepted by the API
+// Parse a time.Time Date / Time in a date string with a format, accepted by the API.
+// The time is converted to the time zone of switzerland first.
 //
 // Returns a connection date and time of type time.Time and an error object
 func (s *ConnectionService) formatDate(date time.Time) (connDate, connTime, error) {
@@ -242,6 +306,9 @@ func (s *ConnectionService) formatDate(date time.Time) (connDate, connTime, erro
 		return "", "", fmt.Errorf("provided date is zero: please provide a valid time.Time as date")
 	}
 
+	// the API expects the local time of switzerland
+	date = date.In(swissLocation())
+
 	// parse date and time
 	d := connDate(date.Format("2006-01-02"))
 	t := connTime(date.Format("15:04"))
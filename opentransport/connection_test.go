@@ -36,9 +36,15 @@ func TestConnectionService_SearchWithOpts(t *testing.T) {
 		t.Errorf("Got %d connections from the api but want %d connections", got, want)
 	}
 
+	// A delay of null is unknown and not on time
+	if got := connResult.Connections[0].From.Delay; got.Valid() {
+		t.Errorf("The delay null was decoded as %s", got)
+	}
+
 	// check the returned struct against a static struct
 	var staticResult ConnectionResult
 	_ = json.Unmarshal(fixture, &staticResult)
+	staticResult.FetchedAt = connResult.FetchedAt
 
 	if got, want := connResult, &staticResult; !reflect.DeepEqual(got, want) {
 		t.Errorf("The proceeded response does not equals the static fixture")
@@ -78,7 +84,8 @@ func TestConnectionService_formatDate(t *testing.T) {
 		t.Errorf("Got formatted connection date %s but does not fit the required format %s", got, want)
 	}
 
-	if got, want := string(connTime), "14:30"; !strings.Contains(got, want) {
+	// 14:30 UTC is 16:30 in switzerland
+	if got, want := string(connTime), "16:30"; !strings.Contains(got, want) {
 		t.Errorf("Got formatted connection time %s but does not fit the required format %s", got, want)
 	}
 }
@@ -94,7 +101,7 @@ func TestConnectionService_buildUrlPath(t *testing.T) {
 		Accessibility: IndependentBoarding,
 	}
 
-	inputDate, _ := time.Parse(time.RFC3339, "2020-04-23T14:30:00.000Z")
+	inputDate, _ := time.Parse(time.RFC3339, "2020-04-23T14:30:00.000+02:00")
 	date, time, err := client.Connection.formatDate(inputDate)
 	if err != nil {
 		t.Errorf("Failed to convert input date %s to formatted date and time", inputDate)
@@ -21,6 +21,15 @@
 //
 // If these limits are reached, you can contact search.ch to find a solution.
 //
+// To stay below these limits, a client side rate limiter can be shared by all services of a client. Requests
+// wait for a free budget or fail with a QuotaError, if the budget is not available before the deadline of the context.
+//
+//	limiter := opentransport.NewRateLimiter(opentransport.RateLimit{PerSecond: 5, PerDay: 1000})
+//	client, err := opentransport.New(opentransport.WithRateLimiter(limiter))
+//
+//	// The remaining budget
+//	budget := limiter.Remaining()
+//
 // Basic Usage
 //
 // The basic functions can be used as follows:
@@ -36,6 +45,162 @@
 //	// Search for connections departing from a location
 //	result, err := client.Stationboard.Search(context.Background(), "Zürich, HB")
 //
+//	// Query the version of the API and measure the latency
+//	info, err := client.Info.Info(context.Background())
+//	latency, err := client.Info.Ping(context.Background())
+//
+// Configuration
+//
+// The client can be configured with options. Use New to handle invalid options as an error. With derives
+// a client with additional options, the configuration of an existing client is never changed.
+//
+//	client, err := opentransport.New(
+//		opentransport.WithBaseURL("http://localhost:3001/v1/"),
+//		opentransport.WithUserAgent("My Departure Board"),
+//		opentransport.WithTimeout(10*time.Second),
+//		opentransport.WithRetryPolicy(opentransport.NoRetry),
+//	)
+//
+// Errors
+//
+// Failed requests return an *APIError with the status code, the request url, an excerpt of the response body
+// and the amount of attempts. The error can be compared against the sentinel errors ErrNotFound, ErrBadRequest,
+// ErrRateLimited, ErrServerError and ErrEmptyResponse.
+//
+//	_, err := client.Stationboard.Search(context.Background(), "Zürich, HB")
+//	if errors.Is(err, opentransport.ErrNotFound) {
+//		// unknown station
+//	}
+//
+// Unknown Values
+//
+// Numbers which can be null in the API, e.g. the delay, the score, the distance, the coordinates and the capacities,
+// are of the type OptionalInt or OptionalFloat. Valid reports whether the API returned a value and Value returns 0 otherwise.
+//
+//	if delay := stop.Delay; delay.Valid() && delay.Value() == 0 {
+//		// on time
+//	}
+//
+// Timestamps
+//
+// Times of the model are a Timestamp, which is zero if the API returned null. A Timestamp is encoded to json
+// in the format of the API, so results can be cached or forwarded. Swiss returns the time in the time zone
+// Europe/Zurich. The dates of a query are converted to the time zone of switzerland before they are sent.
+//
+//	departure := connection.From.Departure.Swiss().Format("15:04")
+//
+// Caching
+//
+// Successful responses can be cached. The time to live is configured per service, e.g. locations for
+// days and stationboards only for seconds.
+//
+//	client, err := opentransport.New(opentransport.WithCache(opentransport.NewLRUCache(1000), opentransport.CacheTTL{
+//		opentransport.ServiceLocation:     72 * time.Hour,
+//		opentransport.ServiceStationboard: 15 * time.Second,
+//	}))
+//
+// Circuit Breaker
+//
+// A circuit breaker stops sending requests after repeated failures. While the circuit is open, requests fail
+// immediately with ErrCircuitOpen or are served by the cache or the offline store.
+//
+//	breaker := opentransport.NewCircuitBreaker(opentransport.BreakerSettings{FailureThreshold: 5})
+//	client, err := opentransport.New(opentransport.WithCircuitBreaker(breaker))
+//
+// Multiple Base URLs
+//
+// With multiple base urls, requests are routed to the best healthy url and fail over to the next one,
+// if a url is not reachable or responds with a server error. Endpoints returns the health of the urls.
+//
+//	client, err := opentransport.New(opentransport.WithBaseURLs("http://mirror.local:3001/v1/", opentransport.DefaultApiURL))
+//
+// Hedged Requests
+//
+// A Hedger sends a second identical request, if the first one has not answered within a delay or a latency
+// percentile. The first successful response wins. Hedge requests are only sent within the budget of the rate limiter.
+//
+//	hedger := opentransport.NewHedger(opentransport.HedgeSettings{Percentile: 0.95})
+//	client, err := opentransport.New(opentransport.WithHedging(hedger))
+//
+// Request Coalescing
+//
+// Concurrent requests with the same url can share one http request, which saves rate limit budget.
+//
+//	client, err := opentransport.New(opentransport.WithCoalescing())
+//
+// Offline Mode
+//
+// In offline mode, every successful response is saved to a Store. If the API is unreachable or responds with a
+// server error, the last stored response of the query is served instead, regardless of its date and time.
+// Such results are marked with Stale and FetchedAt. FileStore.Prune removes old responses.
+//
+//	store, err := opentransport.NewFileStore("/var/cache/opentransport")
+//	client, err := opentransport.New(opentransport.WithOfflineStore(store))
+//
+// Partial Responses
+//
+// The attributes of a response can be limited with Fields. The other attributes keep their zero value.
+//
+//	opts := &opentransport.ConnOpts{Fields: []opentransport.Field{opentransport.ConnectionsFromDeparture}}
+//	result, err := client.Connection.SearchWithOpts(ctx, "Zürich HB", "Bern", time.Now(), opts)
+//
+// Streaming
+//
+// Large responses can be decoded lazily with the iterators of the connection and stationboard services.
+// The maximum response size of all requests can be limited with WithMaxResponseSize.
+//
+//	it, err := client.Stationboard.Stream(ctx, "Zürich HB", opentransport.StbOpts{DateTime: time.Now(), Limit: 40})
+//	defer it.Close()
+//	for it.Next() {
+//		fmt.Println(it.Journey().Name)
+//	}
+//
+// Metrics
+//
+// The client records request counts, latencies, retries, response sizes and cache lookups per service.
+// PrometheusMetrics keeps them in memory and serves them in the Prometheus text format.
+//
+//	metrics := opentransport.NewPrometheusMetrics()
+//	client, err := opentransport.New(opentransport.WithMetrics(metrics))
+//	http.Handle("/metrics", metrics)
+//
+// Tracing
+//
+// A Tracer creates a span for every call of a service and a child span for every http attempt.
+// The spans contain attributes like the from and to locations, the limit and the result count.
+//
+//	client, err := opentransport.New(opentransport.WithTracer(myTracer))
+//
+// Middleware
+//
+// Middlewares wrap every request, e.g. to add authentication, correlation ids or metrics. They see the
+// request created by NewRequest and the raw response body or error before the services parse it.
+//
+//	client, err := opentransport.New(opentransport.WithMiddleware(func(next opentransport.Doer) opentransport.Doer {
+//		return opentransport.DoerFunc(func(req *http.Request) ([]byte, error) {
+//			req.Header.Set("Authorization", "Bearer "+token)
+//			return next.Do(req)
+//		})
+//	}))
+//
+// Unit Tests
+//
+// The services implement the interfaces LocationSearcher, ConnectionSearcher and StationboardSearcher.
+// The package opentransportfake provides programmable fakes of these interfaces for unit tests.
+//
+//	var connections opentransport.ConnectionSearcher = client.Connection
+//	connections = &opentransportfake.ConnectionSearcher{Err: opentransport.ErrServerError}
+//
+// The package opentransporttest starts a fake of the transport API with bundled fixtures and injectable failures.
+//
+//	srv := opentransporttest.NewServer()
+//	client, err := opentransport.New(opentransport.WithBaseURL(srv.URL))
+//
+// The package opentransportrecorder records the requests of a client to cassette files and replays them offline.
+//
+//	rec, err := opentransportrecorder.New("testdata/cassettes/location.json", opentransportrecorder.Options{})
+//	client, err := opentransport.New(opentransport.WithHTTPClient(rec.Client()))
+//
 // Logging
 //
 // The library does not produce log messages by default. However, this can be adjusted. You can either
@@ -51,4 +216,11 @@
 //	multi := io.MultiWriter(f, os.Stdout)
 //	client.EnableLogs(multi)
 //
+// For structured logs, pass a Logger with the option WithLogger. Every http request is logged with
+// the fields method, path, status, latency, attempt and bytes. NewStdLogger writes key=value lines
+// to a log.Logger and NewSlogLogger passes the messages to a slog.Handler.
+//
+//	logger := opentransport.NewSlogLogger(slog.NewJSONHandler(os.Stdout, nil))
+//	client, err := opentransport.New(opentransport.WithLogger(logger))
+//
 package opentransport
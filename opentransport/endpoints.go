@@ -0,0 +1,313 @@
+package opentransport
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Consecutive failures after which an endpoint is marked as unhealthy
+const endpointFailureThreshold = 3
+
+// The time an unhealthy endpoint is only used as last resort
+const endpointCooldown = 30 * time.Second
+
+// The weight of the latest sample in the moving averages of the latency and the error rate
+const endpointSmoothing = 0.3
+
+// Latencies below this value are treated as equally fast
+const endpointLatencyStep = 100 * time.Millisecond
+
+// The health of a base url, returned by Client.Endpoints for debugging.
+type EndpointHealth struct {
+	URL                 string        // The base url of the endpoint.
+	Healthy             bool          // False while the endpoint is in the cooldown after repeated failures.
+	Latency             time.Duration // The moving average of the latency of successful requests.
+	ErrorRate           float64       // The moving average of failed requests between 0 and 1.
+	ConsecutiveFailures int           // Failed requests since the last successful request.
+	Requests            int           // The amount of requests sent to the endpoint.
+	LastError           string        // The error of the last failed request.
+	LastFailure         time.Time     // The time of the last failed request.
+	UnhealthyUntil      time.Time     // The end of the cooldown of an unhealthy endpoint.
+}
+
+// An ordered list of base urls with their health. It is shared by all copies of a configuration.
+type endpointPool struct {
+	mu        sync.Mutex
+	endpoints []*endpoint
+	now       func() time.Time
+}
+
+// A single base url and its health
+type endpoint struct {
+	url       *url.URL
+	index     int
+	latency   time.Duration
+	sampled   bool
+	errorRate float64
+	failures  int
+	requests  int
+	lastErr   error
+	lastFail  time.Time
+	downUntil time.Time
+}
+
+// Creates a pool of the base urls. The order of the urls is the order of preference.
+func newEndpointPool(urls []*url.URL) *endpointPool {
+	p := &endpointPool{now: time.Now}
+	for i, u := range urls {
+		p.endpoints = append(p.endpoints, &endpoint{url: u, index: i})
+	}
+	return p
+}
+
+// Sets multiple base urls of the API, e.g. a self-hosted mirror and the public API as fallback.
+// The first url is the primary one. Requests are routed to the best healthy url and fail over to the
+// next one, if a url is not reachable or responds with a server error. Use Client.Endpoints to inspect
+// the health of the urls.
+func WithBaseURLs(baseURLs ...string) Option {
+	return func(cfg *clientConfig) error {
+		if len(baseURLs) == 0 {
+			return errors.New("please provide at least one base url")
+		}
+
+		urls := make([]*url.URL, 0, len(baseURLs))
+		for _, baseURL := range baseURLs {
+			u, err := parseApiURL(baseURL)
+			if err != nil {
+				return err
+			}
+			if !strings.HasSuffix(u.Path, "/") {
+				u.Path += "/"
+			}
+			urls = append(urls, u)
+		}
+
+		primary := *urls[0]
+		cfg.apiUrl = &primary
+		cfg.endpoints = nil
+		if len(urls) > 1 {
+			cfg.endpoints = newEndpointPool(urls)
+		}
+		return nil
+	}
+}
+
+// Returns the health of all base urls in the configured order. A client with a single base url
+// returns one healthy endpoint without statistics.
+func (c *Client) Endpoints() []EndpointHealth {
+	cfg := c.config()
+	if cfg.endpoints == nil {
+		return []EndpointHealth{{URL: cfg.apiUrl.String(), Healthy: true}}
+	}
+	return cfg.endpoints.health()
+}
+
+// Returns a snapshot of the health of all endpoints in the configured order
+func (p *endpointPool) health() []EndpointHealth {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	health := make([]EndpointHealth, 0, len(p.endpoints))
+	for _, e := range p.endpoints {
+		h := EndpointHealth{
+			URL:                 e.url.String(),
+			Healthy:             e.healthy(now),
+			Latency:             e.latency,
+			ErrorRate:           e.errorRate,
+			ConsecutiveFailures: e.failures,
+			Requests:            e.requests,
+			LastFailure:         e.lastFail,
+			UnhealthyUntil:      e.downUntil,
+		}
+		if e.lastErr != nil {
+			h.LastError = e.lastErr.Error()
+		}
+		health = append(health, h)
+	}
+	return health
+}
+
+// Orders the endpoints by preference. Healthy endpoints come first, ordered by their error rate and
+// latency. Ties keep the configured order. Unhealthy endpoints are appended as last resort.
+//
+// Returns the ordered endpoints
+func (p *endpointPool) ranked() []*endpoint {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	type rank struct {
+		e       *endpoint
+		healthy bool
+		errors  int
+		latency int
+	}
+	ranks := make([]rank, 0, len(p.endpoints))
+	for _, e := range p.endpoints {
+		ranks = append(ranks, rank{
+			e:       e,
+			healthy: e.healthy(now),
+			errors:  int(e.errorRate * 4),
+			latency: latencyClass(e.latency),
+		})
+	}
+
+	sort.SliceStable(ranks, func(i, j int) bool {
+		a, b := ranks[i], ranks[j]
+		if a.healthy != b.healthy {
+			return a.healthy
+		}
+		if !a.healthy {
+			return a.e.downUntil.Before(b.e.downUntil)
+		}
+		if a.errors != b.errors {
+			return a.errors < b.errors
+		}
+		return a.latency < b.latency
+	})
+
+	ordered := make([]*endpoint, len(ranks))
+	for i, r := range ranks {
+		ordered[i] = r.e
+	}
+	return ordered
+}
+
+// Groups latencies into classes which double in size, so that small differences do not change the order.
+//
+// Returns 0 for latencies below endpointLatencyStep and an increasing class otherwise
+func latencyClass(latency time.Duration) int {
+	class := 0
+	for step := endpointLatencyStep; latency >= step; step *= 2 {
+		class++
+	}
+	return class
+}
+
+// Records the result of a request to an endpoint. Canceled requests and exceeded quotas are ignored.
+func (p *endpointPool) record(e *endpoint, latency time.Duration, err error) {
+	if err != nil && !endpointFailure(err) {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	e.requests++
+	if err != nil {
+		now := p.now()
+		e.failures++
+		e.errorRate = e.errorRate*(1-endpointSmoothing) + endpointSmoothing
+		e.lastErr = err
+		e.lastFail = now
+		if e.failures >= endpointFailureThreshold {
+			e.downUntil = now.Add(endpointCooldown)
+		}
+		return
+	}
+
+	e.failures = 0
+	e.downUntil = time.Time{}
+	e.errorRate *= 1 - endpointSmoothing
+	if !e.sampled {
+		e.latency = latency
+		e.sampled = true
+		return
+	}
+	e.latency = time.Duration(float64(e.latency)*(1-endpointSmoothing) + float64(latency)*endpointSmoothing)
+}
+
+// Reports whether the endpoint is not in the cooldown after repeated failures
+func (e *endpoint) healthy(now time.Time) bool {
+	return !now.Before(e.downUntil)
+}
+
+// Decides if an error is caused by the endpoint. Network errors including timeouts, rate limit errors and
+// server errors are failures, canceled requests and client errors are not. Errors of a caller who gave up
+// are filtered by failover before.
+//
+// Returns true if the next endpoint should be tried
+func endpointFailure(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, ErrQuotaExceeded) {
+		return false
+	}
+	return breakerFailure(err)
+}
+
+// Sends an attempt to the best healthy endpoint and fails over to the next endpoints in the ranked
+// order. Without multiple base urls, the request is sent unchanged.
+//
+// Returns the response of the first successful endpoint or the error of the last one
+func (c *Client) failover(cfg *clientConfig, req *http.Request, service Service, attempt int, stream bool) ([]byte, io.ReadCloser, error) {
+	pool := cfg.endpoints
+	if pool == nil {
+		return c.attempt(cfg, req, service, attempt, stream)
+	}
+
+	ranked := pool.ranked()
+	var lastErr error
+	for i, e := range ranked {
+		epReq, ok := rewriteRequest(req, cfg.apiUrl, e.url)
+		if !ok {
+			// The request does not target the api, e.g. an absolute url of a custom request
+			return c.attempt(cfg, req, service, attempt, stream)
+		}
+
+		start := time.Now()
+		body, reader, err := c.attempt(cfg, epReq, service, attempt, stream)
+		latency := time.Since(start)
+		if err == nil {
+			pool.record(e, latency, nil)
+			return body, reader, nil
+		}
+
+		// The caller gave up, which says nothing about the endpoint
+		if req.Context().Err() != nil {
+			return nil, nil, err
+		}
+
+		pool.record(e, latency, err)
+		lastErr = err
+		if !endpointFailure(err) {
+			return nil, nil, err
+		}
+		if i+1 < len(ranked) {
+			cfg.logger.Log(LevelWarn, "endpoint failed", "endpoint", e.url.String(),
+				"next", ranked[i+1].url.String(), "error", err)
+		}
+	}
+	return nil, nil, lastErr
+}
+
+// Moves a request from the base url to the target url. The path below the base url and the query are kept.
+//
+// Returns the request for the target and false, if the request is not below the base url
+func rewriteRequest(req *http.Request, base *url.URL, target *url.URL) (*http.Request, bool) {
+	u := req.URL
+	if u.Scheme != base.Scheme || u.Host != base.Host || !strings.HasPrefix(u.Path, base.Path) {
+		return req, false
+	}
+	if target.Scheme == base.Scheme && target.Host == base.Host && target.Path == base.Path {
+		return req, true
+	}
+
+	rewritten := *u
+	rewritten.Scheme = target.Scheme
+	rewritten.Host = target.Host
+	rewritten.User = target.User
+	rewritten.Path = target.Path + strings.TrimPrefix(u.Path, base.Path)
+	rewritten.RawPath = ""
+
+	r := req.Clone(req.Context())
+	r.URL = &rewritten
+	r.Host = ""
+	return r, true
+}
@@ -0,0 +1,253 @@
+package opentransport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// Starts a server which counts the requests and responds with the status or the location fixture
+func newEndpointServer(t *testing.T, status *int32, hits *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(hits, 1)
+		if code := atomic.LoadInt32(status); code != http.StatusOK {
+			w.WriteHeader(int(code))
+			return
+		}
+		if r.URL.Path != "/v1/locations" {
+			t.Errorf("The request was sent to %s instead of /v1/locations", r.URL.Path)
+		}
+		fixture, _ := readFixture("location_search")
+		_, _ = w.Write(fixture)
+	}))
+}
+
+func TestClient_Failover(t *testing.T) {
+	primaryStatus, mirrorStatus := int32(http.StatusBadGateway), int32(http.StatusOK)
+	var primaryHits, mirrorHits int32
+
+	primary := newEndpointServer(t, &primaryStatus, &primaryHits)
+	defer primary.Close()
+	mirror := newEndpointServer(t, &mirrorStatus, &mirrorHits)
+	defer mirror.Close()
+
+	c, err := New(WithBaseURLs(primary.URL+"/v1", mirror.URL+"/v1/"), WithRetryPolicy(NoRetry))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.Location.Search(context.Background(), "Bern"); err != nil {
+		t.Fatalf("The request should fail over to the mirror: %s", err)
+	}
+	if primaryHits != 1 || mirrorHits != 1 {
+		t.Errorf("Got %d requests to the primary and %d to the mirror instead of 1 and 1", primaryHits, mirrorHits)
+	}
+
+	// The failed primary is ranked behind the mirror
+	for i := 0; i < 3; i++ {
+		_, _ = c.Location.Search(context.Background(), "Bern")
+	}
+	if primaryHits != 1 || mirrorHits != 4 {
+		t.Errorf("Got %d requests to the primary and %d to the mirror instead of 1 and 4", primaryHits, mirrorHits)
+	}
+
+	health := c.Endpoints()
+	if len(health) != 2 || health[0].URL != primary.URL+"/v1/" || health[1].URL != mirror.URL+"/v1/" {
+		t.Fatalf("The endpoints should be returned in the configured order: %+v", health)
+	}
+	if health[0].ConsecutiveFailures != 1 || health[0].ErrorRate == 0 || health[0].LastError == "" {
+		t.Errorf("The failure of the primary is missing: %+v", health[0])
+	}
+	if health[1].Requests != 4 || health[1].ErrorRate != 0 || !health[1].Healthy {
+		t.Errorf("The statistics of the mirror are wrong: %+v", health[1])
+	}
+
+	// If all endpoints fail, the error of the last one is returned
+	atomic.StoreInt32(&mirrorStatus, http.StatusServiceUnavailable)
+	_, err = c.Location.Search(context.Background(), "Bern")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadGateway {
+		t.Errorf("Want the error of the primary as last resort but got %v", err)
+	}
+}
+
+func TestClient_FailoverClientError(t *testing.T) {
+	primaryStatus, mirrorStatus := int32(http.StatusNotFound), int32(http.StatusOK)
+	var primaryHits, mirrorHits int32
+
+	primary := newEndpointServer(t, &primaryStatus, &primaryHits)
+	defer primary.Close()
+	mirror := newEndpointServer(t, &mirrorStatus, &mirrorHits)
+	defer mirror.Close()
+
+	c, _ := New(WithBaseURLs(primary.URL+"/v1", mirror.URL+"/v1"), WithRetryPolicy(NoRetry))
+	_, err := c.Location.Search(context.Background(), "Bern")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("A client error should be returned without failover but got %v", err)
+	}
+	if mirrorHits != 0 {
+		t.Errorf("The mirror should not be used for client errors")
+	}
+}
+
+func TestEndpointPool_Ranked(t *testing.T) {
+	a, _ := url.Parse("http://a/v1/")
+	b, _ := url.Parse("http://b/v1/")
+	c, _ := url.Parse("http://c/v1/")
+
+	now := time.Now()
+	p := newEndpointPool([]*url.URL{a, b, c})
+	p.now = func() time.Time { return now }
+
+	order := func() string {
+		var s string
+		for _, e := range p.ranked() {
+			s += e.url.Host
+		}
+		return s
+	}
+
+	if got := order(); got != "abc" {
+		t.Errorf("Without statistics, the configured order should be kept but got %s", got)
+	}
+
+	// Small differences of the latency do not change the order
+	p.record(p.endpoints[0], 60*time.Millisecond, nil)
+	p.record(p.endpoints[1], 20*time.Millisecond, nil)
+	if got := order(); got != "abc" {
+		t.Errorf("Got order %s instead of abc", got)
+	}
+
+	p.record(p.endpoints[0], 900*time.Millisecond, nil)
+	if got := order(); got != "bca" {
+		t.Errorf("A slow endpoint should be ranked last but got %s", got)
+	}
+
+	// Unhealthy endpoints are tried last until the cooldown expired
+	for i := 0; i < endpointFailureThreshold; i++ {
+		p.record(p.endpoints[1], time.Millisecond, &APIError{StatusCode: http.StatusInternalServerError})
+	}
+	if got := order(); got != "cab" {
+		t.Errorf("An unhealthy endpoint should be ranked last but got %s", got)
+	}
+
+	p.record(p.endpoints[2], time.Millisecond, context.Canceled)
+	if p.endpoints[2].requests != 0 {
+		t.Errorf("A canceled request should not be recorded")
+	}
+
+	now = now.Add(endpointCooldown)
+	if got := p.health()[1]; !got.Healthy {
+		t.Errorf("The endpoint should be healthy after the cooldown")
+	}
+}
+
+func TestRewriteRequest(t *testing.T) {
+	base, _ := url.Parse("http://primary/v1/")
+	target, _ := url.Parse("https://mirror:8443/api/v1/")
+
+	req, _ := http.NewRequest(http.MethodGet, "http://primary/v1/locations?query=Z%C3%BCrich", nil)
+	r, ok := rewriteRequest(req, base, target)
+	if !ok {
+		t.Fatal("The request should be rewritten")
+	}
+	if got, want := r.URL.String(), "https://mirror:8443/api/v1/locations?query=Z%C3%BCrich"; got != want {
+		t.Errorf("Got url %s instead of %s", got, want)
+	}
+	if req.URL.Host != "primary" {
+		t.Errorf("The original request must not be modified")
+	}
+
+	other, _ := http.NewRequest(http.MethodGet, "http://other/v1/locations", nil)
+	if r, ok := rewriteRequest(other, base, target); ok || r != other {
+		t.Errorf("A request of another host should not be rewritten")
+	}
+}
+
+func TestWithBaseURLs(t *testing.T) {
+	if _, err := New(WithBaseURLs()); err == nil {
+		t.Errorf("An empty list of base urls should be an error")
+	}
+	if _, err := New(WithBaseURLs("http://a/v1", "mirror")); err == nil {
+		t.Errorf("An invalid base url should be an error")
+	}
+
+	c, _ := New(WithBaseURLs("http://a/v1"))
+	if got := c.Endpoints(); len(got) != 1 || got[0].URL != "http://a/v1/" || !got[0].Healthy {
+		t.Errorf("A single base url should be a healthy endpoint: %+v", got)
+	}
+
+	// A later base url replaces the list
+	c, _ = New(WithBaseURLs("http://a/v1", "http://b/v1"), WithBaseURL("http://c/v1/"))
+	if got := c.Endpoints(); len(got) != 1 || got[0].URL != "http://c/v1/" {
+		t.Errorf("WithBaseURL should replace the endpoints: %+v", got)
+	}
+}
+
+func TestClient_FailoverTimeout(t *testing.T) {
+	var slowHits int32
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&slowHits, 1)
+		<-r.Context().Done()
+	}))
+	defer slow.Close()
+
+	fastStatus := int32(http.StatusOK)
+	var fastHits int32
+	fast := newEndpointServer(t, &fastStatus, &fastHits)
+	defer fast.Close()
+
+	c, err := New(WithBaseURLs(slow.URL+"/v1/", fast.URL+"/v1/"), WithTimeout(50*time.Millisecond), WithRetryPolicy(NoRetry))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.Location.Search(context.Background(), "Bern"); err != nil {
+		t.Fatalf("The request should fail over to the fast endpoint: %s", err)
+	}
+	if slowHits != 1 || fastHits != 1 {
+		t.Errorf("Got %d requests to the slow and %d to the fast endpoint instead of 1 and 1", slowHits, fastHits)
+	}
+
+	health := c.Endpoints()
+	if health[0].Requests != 1 || health[0].ConsecutiveFailures != 1 {
+		t.Errorf("The timeout of the slow endpoint should be recorded: %+v", health[0])
+	}
+}
+
+func TestClient_FailoverCanceled(t *testing.T) {
+	var slowHits int32
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&slowHits, 1)
+		<-r.Context().Done()
+	}))
+	defer slow.Close()
+
+	fastStatus := int32(http.StatusOK)
+	var fastHits int32
+	fast := newEndpointServer(t, &fastStatus, &fastHits)
+	defer fast.Close()
+
+	c, err := New(WithBaseURLs(slow.URL+"/v1/", fast.URL+"/v1/"), WithRetryPolicy(NoRetry))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A deadline of the caller is not a failure of the endpoint
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := c.Location.Search(ctx, "Bern"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Got %v instead of the deadline of the caller", err)
+	}
+	if fastHits != 0 {
+		t.Errorf("The request should not fail over after the caller gave up")
+	}
+	if health := c.Endpoints(); health[0].Requests != 0 {
+		t.Errorf("The request of the caller who gave up should not be recorded: %+v", health[0])
+	}
+}
@@ -0,0 +1,100 @@
+package opentransport
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// The maximum amount of bytes of a response body which will be kept in an APIError
+const maxErrorBodyExcerpt = 512
+
+// Sentinel errors which can be compared with errors.Is against any error returned by the services.
+var (
+	// The requested resource does not exist (HTTP 404).
+	ErrNotFound = errors.New("opentransport: resource not found")
+
+	// The API rejected the request parameters (HTTP 400).
+	ErrBadRequest = errors.New("opentransport: bad request")
+
+	// The rate limit of the API is exceeded (HTTP 429).
+	ErrRateLimited = errors.New("opentransport: rate limited")
+
+	// The API responded with a server error (HTTP 5xx).
+	ErrServerError = errors.New("opentransport: server error")
+
+	// The API responded without a body.
+	ErrEmptyResponse = errors.New("opentransport: empty response")
+
+	// The response body exceeds the max response size of the client.
+	ErrResponseTooLarge = errors.New("opentransport: response too large")
+)
+
+// An APIError describes a failed request against the API. It is returned by Client.Do and
+// therefore by all services. Use errors.As to access the details and errors.Is to compare it
+// against one of the sentinel errors like ErrNotFound or ErrServerError.
+type APIError struct {
+	StatusCode int           // The http status code of the last response. Is 0 if no response was received.
+	URL        string        // The url of the failed request.
+	Body       string        // An excerpt of the response body, limited to 512 bytes.
+	Attempts   int           // The amount of attempts which were made, including retries.
+	RetryAfter time.Duration // The pause requested by the server with a Retry-After header. Is 0 if not provided.
+	Err        error         // The underlying error, e.g. a network error. Can be nil.
+}
+
+// Returns a human readable description of the failed request
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("opentransport: failed to perform the http request after %d attempts: GET %s", e.Attempts, e.URL)
+	if e.StatusCode > 0 {
+		msg = fmt.Sprintf("%s: %d %s", msg, e.StatusCode, http.StatusText(e.StatusCode))
+	}
+	if e.Err != nil {
+		msg = fmt.Sprintf("%s: %s", msg, e.Err)
+	}
+	return msg
+}
+
+// Returns the underlying error
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// Reports whether the error matches one of the sentinel errors, based on the status code.
+func (e *APIError) Is(target error) bool {
+	s := statusSentinel(e.StatusCode)
+	return s != nil && s == target
+}
+
+// Checks if the error is temporary and the request should be repeated.
+// Network errors, server errors and rate limit errors are temporary, client errors are not.
+//
+// Returns true if the request can be retried
+func (e *APIError) Temporary() bool {
+	return e.StatusCode == 0 || e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= http.StatusInternalServerError
+}
+
+// Maps a http status code to a sentinel error.
+//
+// Returns the sentinel error or nil if there is no matching sentinel
+func statusSentinel(status int) error {
+	switch {
+	case status == http.StatusNotFound:
+		return ErrNotFound
+	case status == http.StatusBadRequest:
+		return ErrBadRequest
+	case status == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case status >= http.StatusInternalServerError:
+		return ErrServerError
+	}
+	return nil
+}
+
+// Shortens a response body to an excerpt which can be stored in an APIError
+func bodyExcerpt(body []byte) string {
+	if len(body) > maxErrorBodyExcerpt {
+		body = body[:maxErrorBodyExcerpt]
+	}
+	return string(body)
+}
@@ -0,0 +1,126 @@
+package opentransport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAPIError_Sentinels(t *testing.T) {
+	srv, client, terminate := prepare()
+	defer terminate()
+
+	client = mustWith(t, client, WithRetryPolicy(ConstantBackoff{Attempts: 2, Pause: time.Millisecond}))
+
+	status := 0
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte("upstream message"))
+	}
+	srv.HandleFunc("/locations", handler)
+	srv.HandleFunc("/connections", handler)
+	srv.HandleFunc("/stationboard", handler)
+
+	calls := []struct {
+		name string
+		call func() error
+	}{
+		{"location", func() error {
+			_, err := client.Location.Search(context.Background(), "Zürich")
+			return err
+		}},
+		{"connection", func() error {
+			_, err := client.Connection.Search(context.Background(), "Zürich", "Bern", time.Now())
+			return err
+		}},
+		{"stationboard", func() error {
+			_, err := client.Stationboard.Search(context.Background(), "Zürich")
+			return err
+		}},
+	}
+
+	testValues := []struct {
+		status   int
+		want     error
+		attempts int
+	}{
+		{http.StatusNotFound, ErrNotFound, 1},
+		{http.StatusBadRequest, ErrBadRequest, 1},
+		{http.StatusTooManyRequests, ErrRateLimited, 2},
+		{http.StatusBadGateway, ErrServerError, 2},
+	}
+
+	for _, tv := range testValues {
+		status = tv.status
+		for _, c := range calls {
+			err := c.call()
+			if !errors.Is(err, tv.want) {
+				t.Errorf("The %s service should return %s for status %d but got %v", c.name, tv.want, tv.status, err)
+				continue
+			}
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Errorf("The %s service did not return an APIError: %v", c.name, err)
+				continue
+			}
+
+			if got, want := apiErr.StatusCode, tv.status; got != want {
+				t.Errorf("The api error has status %d but want %d", got, want)
+			}
+
+			if got, want := apiErr.Attempts, tv.attempts; got != want {
+				t.Errorf("The api error reports %d attempts but want %d", got, want)
+			}
+
+			if got, want := apiErr.Body, "upstream message"; got != want {
+				t.Errorf("The api error contains the body '%s' but want '%s'", got, want)
+			}
+		}
+	}
+}
+
+func TestAPIError_EmptyResponse(t *testing.T) {
+	srv, client, terminate := prepare()
+	defer terminate()
+
+	srv.HandleFunc("/stationboard", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	_, err := client.Stationboard.Search(context.Background(), "Zürich")
+	if !errors.Is(err, ErrEmptyResponse) {
+		t.Errorf("An empty response should return ErrEmptyResponse but got %v", err)
+	}
+}
+
+func TestAPIError_NetworkError(t *testing.T) {
+	c, _ := NewClientWithUrl(nil, "http://127.0.0.1:1/v1/")
+	_ = c.MaxRetry(1, 1)
+
+	_, err := c.Location.Search(context.Background(), "Zürich")
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("A network error should be returned as APIError but got %v", err)
+	}
+
+	if apiErr.Err == nil {
+		t.Errorf("The underlying network error should be wrapped")
+	}
+
+	if errors.Is(err, ErrServerError) {
+		t.Errorf("A network error should not match ErrServerError")
+	}
+}
+
+func TestAPIError_bodyExcerpt(t *testing.T) {
+	body := []byte(strings.Repeat("x", 2*maxErrorBodyExcerpt))
+
+	if got, want := len(bodyExcerpt(body)), maxErrorBodyExcerpt; got != want {
+		t.Errorf("The body excerpt has %d bytes but want %d", got, want)
+	}
+}
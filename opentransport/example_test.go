@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"time"
 )
 
 func ExampleNewClient() {
@@ -28,6 +29,30 @@ func ExampleNewClient() {
 	fmt.Printf("All returend stations %+v", stations)
 }
 
+func ExampleNew() {
+
+	// Create new client with custom options
+	client, err := New(
+		WithBaseURL("http://localhost:8080/v1/"),
+		WithUserAgent("My Departure Board"),
+		WithTimeout(10*time.Second),
+		WithRetryPolicy(ExponentialBackoff{Attempts: 3, Initial: time.Second}),
+		WithCache(NewLRUCache(100), nil),
+	)
+
+	// Check if the client could be created
+	if err != nil {
+		fmt.Printf("Failed to create a new client because of %s", err)
+		return
+	}
+
+	// Use a service method
+	stations, err := client.Location.Search(context.Background(), "Zürich")
+
+	// Print the returned array of stations
+	fmt.Printf("All returend stations %+v", stations)
+}
+
 func ExampleNewClientWithUrl() {
 
 	// Create new default client
@@ -0,0 +1,143 @@
+package opentransport
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// A Field selects an attribute of a response with its json path, e.g. connections/from/departure.
+// If fields are set, the API returns only the selected attributes, all other attributes of the
+// result types keep their zero value. Fields are validated against the result type of a service and
+// sent with its json names, so Stationboard/Name selects stationboard/name.
+type Field string
+
+// Common fields of the connection service
+const (
+	ConnectionsFromDeparture       Field = "connections/from/departure"
+	ConnectionsFromPlatform        Field = "connections/from/platform"
+	ConnectionsFromDelay           Field = "connections/from/delay"
+	ConnectionsToArrival           Field = "connections/to/arrival"
+	ConnectionsToPlatform          Field = "connections/to/platform"
+	ConnectionsDuration            Field = "connections/duration"
+	ConnectionsTransfers           Field = "connections/transfers"
+	ConnectionsProducts            Field = "connections/products"
+	ConnectionsSectionsJourneyName Field = "connections/sections/journey/name"
+	ConnectionsSectionsJourneyTo   Field = "connections/sections/journey/to"
+)
+
+// Common fields of the stationboard service
+const (
+	StationName                        Field = "station/name"
+	StationboardName                   Field = "stationboard/name"
+	StationboardCategory               Field = "stationboard/category"
+	StationboardNumber                 Field = "stationboard/number"
+	StationboardTo                     Field = "stationboard/to"
+	StationboardStopDeparture          Field = "stationboard/stop/departure"
+	StationboardStopDelay              Field = "stationboard/stop/delay"
+	StationboardStopPlatform           Field = "stationboard/stop/platform"
+	StationboardStopPrognosisDeparture Field = "stationboard/stop/prognosis/departure"
+)
+
+// Common fields of the location service
+const (
+	StationsId         Field = "stations/id"
+	StationsName       Field = "stations/name"
+	StationsCoordinate Field = "stations/coordinate"
+	StationsIcon       Field = "stations/icon"
+)
+
+// The type of the json unmarshaler interface, types which implement it are not traversed
+var unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// Validates the fields against the result type and generates a URL encoded string which can be appended to an url path.
+// The fields are sent with the json names of the result type, e.g. Stationboard/Name as stationboard/name.
+// Example: &fields[]=connections/from/departure
+//
+// Returns a url path string or an error if a field does not exist in the result type
+func convFields(fields []Field, result interface{}) (string, error) {
+	root := reflect.TypeOf(result)
+	values := make([]string, len(fields))
+	for i, f := range fields {
+		path, err := validField(root, f)
+		if err != nil {
+			return "", err
+		}
+		values[i] = string(path)
+	}
+	return convListParam(values, "fields")
+}
+
+// Checks if the json path of a field exists in a type. The names of the path are matched like
+// encoding/json does, so the returned path may differ in case from the field.
+//
+// Returns the path with the json names of the type or an error if the field does not exist
+func validField(t reflect.Type, f Field) (Field, error) {
+	if len(f) == 0 {
+		return "", fmt.Errorf("the field can not be empty")
+	}
+
+	names := strings.Split(string(f), "/")
+	for i, name := range names {
+		child, key, ok := jsonField(t, name)
+		if !ok {
+			return "", fmt.Errorf("the field %s does not exist: unknown attribute %s", f, name)
+		}
+		names[i] = key
+		t = child
+	}
+	return Field(strings.Join(names, "/")), nil
+}
+
+// Searches the attribute with the json name in a struct type. Like encoding/json, an exact match is
+// preferred, otherwise the name is matched case insensitive. The attributes of embedded structs are promoted.
+//
+// Returns the type and the json name of the attribute and true, or false if there is no such attribute
+func jsonField(t reflect.Type, name string) (reflect.Type, string, bool) {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct || reflect.PtrTo(t).Implements(unmarshalerType) || len(name) == 0 {
+		return nil, "", false
+	}
+
+	var fold reflect.Type
+	foldKey := ""
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := strings.Split(sf.Tag.Get("json"), ",")[0]
+		if tag == "-" {
+			continue
+		}
+
+		if sf.Anonymous && len(tag) == 0 {
+			if child, key, ok := jsonField(sf.Type, name); ok {
+				if key == name {
+					return child, key, true
+				}
+				if fold == nil {
+					fold, foldKey = child, key
+				}
+			}
+			continue
+		}
+
+		if len(sf.PkgPath) > 0 {
+			continue // unexported
+		}
+
+		key := tag
+		if len(key) == 0 {
+			key = sf.Name
+		}
+		if key == name {
+			return sf.Type, key, true
+		}
+		if fold == nil && strings.EqualFold(key, name) {
+			fold, foldKey = sf.Type, key
+		}
+	}
+	return fold, foldKey, fold != nil
+}
@@ -0,0 +1,147 @@
+package opentransport
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidField(t *testing.T) {
+	testValues := []struct {
+		result interface{}
+		field  Field
+		valid  bool
+	}{
+		{ConnectionResult{}, ConnectionsFromDeparture, true},
+		{ConnectionResult{}, ConnectionsSectionsJourneyName, true},
+		{ConnectionResult{}, "connections/sections/journey/passList/station/coordinate/x", true},
+		{ConnectionResult{}, "stations/from/name", true},
+		{ConnectionResult{}, "connections/from/departure/year", false},
+		{ConnectionResult{}, "connections/stale", false},
+		{ConnectionResult{}, "Stale", false},
+		{ConnectionResult{}, "connections//from", false},
+		{ConnectionResult{}, "", false},
+		{StationboardResult{}, StationboardName, true},
+		{StationboardResult{}, StationboardStopPrognosisDeparture, true},
+		{StationboardResult{}, "stationboard/passList/platform", true},
+		{StationboardResult{}, "connections/from", false},
+		{LocationResult{}, StationsCoordinate, true},
+		{LocationResult{}, "stations/coordinate/type", true},
+		{LocationResult{}, "stations/coordinates", false},
+	}
+
+	for _, tv := range testValues {
+		_, err := validField(reflect.TypeOf(tv.result), tv.field)
+		if got := err == nil; got != tv.valid {
+			t.Errorf("The field %q of %T is valid %t but want %t: %v", tv.field, tv.result, got, tv.valid, err)
+		}
+	}
+}
+
+func TestValidField_Normalize(t *testing.T) {
+	testValues := []struct {
+		result interface{}
+		field  Field
+		want   Field
+	}{
+		{StationboardResult{}, "Stationboard/Name", StationboardName},
+		{StationboardResult{}, "STATIONBOARD/STOP/DEPARTURE", StationboardStopDeparture},
+		{StationboardResult{}, "stationboard/passlist/platform", "stationboard/passList/platform"},
+		{ConnectionResult{}, "Connections/Sections/Journey/Name", ConnectionsSectionsJourneyName},
+		{LocationResult{}, "Stations/Coordinate/X", "stations/coordinate/x"},
+		{LocationResult{}, StationsName, StationsName},
+	}
+
+	for _, tv := range testValues {
+		got, err := validField(reflect.TypeOf(tv.result), tv.field)
+		if err != nil {
+			t.Errorf("The field %q of %T should be valid: %s", tv.field, tv.result, err)
+			continue
+		}
+		if got != tv.want {
+			t.Errorf("The field %q is normalized to %q but want %q", tv.field, got, tv.want)
+		}
+	}
+}
+
+func TestConvFields(t *testing.T) {
+	got, err := convFields([]Field{ConnectionsFromDeparture, ConnectionsSectionsJourneyName}, ConnectionResult{})
+	if err != nil {
+		t.Fatalf("Failed to convert valid fields: %s", err)
+	}
+
+	want := "&fields[]=connections%2Ffrom%2Fdeparture&fields[]=connections%2Fsections%2Fjourney%2Fname"
+	if got != want {
+		t.Errorf("The fields are encoded as %s but want %s", got, want)
+	}
+
+	// The api matches the fields case sensitive, so the json names are sent
+	got, err = convFields([]Field{"Connections/From/Departure"}, ConnectionResult{})
+	if want := "&fields[]=connections%2Ffrom%2Fdeparture"; err != nil || got != want {
+		t.Errorf("The field is encoded as %s but want %s: %v", got, want, err)
+	}
+
+	if got, _ := convFields(nil, ConnectionResult{}); got != "" {
+		t.Errorf("Without fields the url should not be changed but got %s", got)
+	}
+}
+
+func TestFields_Request(t *testing.T) {
+	mux, client, teardown := prepare()
+	defer teardown()
+
+	var query []string
+	mux.HandleFunc("/stationboard", func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()["fields[]"]
+		_, _ = w.Write([]byte(`{"stationboard":[{"stop":{"departure":"2020-05-02T20:03:00+0200"}}]}`))
+	})
+	mux.HandleFunc("/locations", func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()["fields[]"]
+		_, _ = w.Write([]byte(`{"stations":[{"name":"Bern"}]}`))
+	})
+
+	opts := StbOpts{DateTime: time.Now(), Limit: 3, Fields: []Field{StationboardStopDeparture}}
+	result, err := client.Stationboard.SearchWithOpts(context.Background(), "Bern", opts)
+	if err != nil {
+		t.Fatalf("Failed to search with fields: %s", err)
+	}
+
+	if got, want := strings.Join(query, ","), string(StationboardStopDeparture); got != want {
+		t.Errorf("The api received the fields %s but want %s", got, want)
+	}
+
+	if len(result.Journeys) != 1 || result.Journeys[0].Stop.Departure.IsZero() || len(result.Journeys[0].Name) > 0 {
+		t.Errorf("The partial response should be decoded into the result type but got %+v", result.Journeys)
+	}
+
+	locations, err := client.Location.SearchWithOpts(context.Background(), "Bern", LocOpts{Fields: []Field{StationsName}})
+	if err != nil {
+		t.Fatalf("Failed to search locations with fields: %s", err)
+	}
+
+	if len(locations) != 1 || locations[0].Name != "Bern" || query[0] != string(StationsName) {
+		t.Errorf("The partial location response should be decoded but got %+v", locations)
+	}
+
+	// A field in another case is sent with the json names
+	if _, err := client.Location.SearchWithOpts(context.Background(), "Bern", LocOpts{Fields: []Field{"Stations/Name"}}); err != nil {
+		t.Fatalf("Failed to search locations with fields: %s", err)
+	}
+	if got, want := strings.Join(query, ","), string(StationsName); got != want {
+		t.Errorf("The api received the fields %s but want %s", got, want)
+	}
+
+	// Invalid fields are rejected before the request
+	opts.Fields = []Field{"stationboard/unknown"}
+	if _, err := client.Stationboard.SearchWithOpts(context.Background(), "Bern", opts); err == nil || !strings.Contains(err.Error(), "unknown attribute") {
+		t.Errorf("An invalid field should return an error but got %v", err)
+	}
+
+	connOpts := &ConnOpts{Fields: []Field{StationboardName}}
+	if _, err := client.Connection.SearchWithOpts(context.Background(), "Bern", "Thun", time.Now(), connOpts); err == nil {
+		t.Errorf("A field of another service should return an error")
+	}
+}
@@ -0,0 +1,53 @@
+//go:build ignore
+// +build ignore
+
+// Generates zoneinfo_zurich.go out of the Europe/Zurich file of the system time zone database.
+// The file has to contain the transitions up to 2037 ("fat" format), because older Go versions
+// do not evaluate the rule of "slim" files. Run it with go generate in the directory of the
+// opentransport package.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"strconv"
+	"time"
+)
+
+func main() {
+	file := flag.String("file", "/usr/share/zoneinfo/Europe/Zurich", "the zoneinfo file of Europe/Zurich")
+	flag.Parse()
+
+	raw, err := ioutil.ReadFile(*file)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	loc, err := time.LoadLocationFromTZData("Europe/Zurich", raw)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Without the transitions, the summer time of later years would be missing
+	if _, offset := time.Date(2036, 7, 1, 12, 0, 0, 0, loc).Zone(); len(raw) < 1000 || offset != 2*60*60 {
+		log.Fatalf("%s is not in the fat format", *file)
+	}
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by gen_zoneinfo.go; DO NOT EDIT.\n\n")
+	b.WriteString("package opentransport\n\n")
+	b.WriteString("// The zoneinfo of Europe/Zurich, used if the time zone database of the system is not available\n")
+	fmt.Fprintf(&b, "const zurichTZData = %s\n", strconv.Quote(string(raw)))
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("zoneinfo_zurich.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
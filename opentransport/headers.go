@@ -0,0 +1,83 @@
+package opentransport
+
+import (
+	"context"
+	"net/http"
+)
+
+// The context key of per-call headers
+type headerContextKey struct{}
+
+// Adds a static header to all requests of the client, e.g. an api key of a self-hosted mirror
+// or an Accept-Language header. Multiple calls with the same key add multiple values.
+func WithHeader(key string, value string) Option {
+	return func(cfg *clientConfig) error {
+		if cfg.headers == nil {
+			cfg.headers = make(http.Header)
+		}
+		cfg.headers.Add(key, value)
+		return nil
+	}
+}
+
+// Adds static headers to all requests of the client. See WithHeader.
+func WithHeaders(header http.Header) Option {
+	return func(cfg *clientConfig) error {
+		if cfg.headers == nil {
+			cfg.headers = make(http.Header)
+		}
+		for key, values := range header {
+			for _, v := range values {
+				cfg.headers.Add(key, v)
+			}
+		}
+		return nil
+	}
+}
+
+// Returns a copy of the context which carries headers for a single call. The headers are added to all
+// requests created with this context and override the static headers of the client with the same key.
+// Headers already stored in the context are kept, unless they are overridden.
+//
+//	ctx := opentransport.ContextWithHeaders(ctx, http.Header{"X-Correlation-Id": []string{"42"}})
+//	result, err := client.Stationboard.Search(ctx, "Zürich HB")
+func ContextWithHeaders(ctx context.Context, header http.Header) context.Context {
+	merged := HeadersFromContext(ctx)
+	if merged == nil {
+		merged = make(http.Header)
+	}
+	for key, values := range header {
+		merged[http.CanonicalHeaderKey(key)] = append([]string(nil), values...)
+	}
+	return context.WithValue(ctx, headerContextKey{}, merged)
+}
+
+// Returns a copy of the per-call headers stored in the context or nil if there are none.
+func HeadersFromContext(ctx context.Context) http.Header {
+	if ctx == nil {
+		return nil
+	}
+	if h, ok := ctx.Value(headerContextKey{}).(http.Header); ok {
+		return h.Clone()
+	}
+	return nil
+}
+
+// Sets the headers of a request in the following order, where a later step overrides an earlier one:
+// the User-Agent of the client, the static headers of the client and the per-call headers of the context.
+func (cfg *clientConfig) setHeaders(req *http.Request) {
+	req.Header.Set("User-Agent", cfg.userAgent)
+
+	for key, values := range cfg.headers {
+		req.Header[key] = append([]string(nil), values...)
+	}
+
+	for key, values := range HeadersFromContext(req.Context()) {
+		req.Header[key] = values
+	}
+
+	// The user agent has to be present in every request
+	if len(req.Header.Get("User-Agent")) == 0 {
+		req.Header.Set("User-Agent", DefaultUserAgent)
+	}
+}
@@ -0,0 +1,88 @@
+package opentransport
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestClient_NewRequestHeaders(t *testing.T) {
+	client, err := New(
+		WithUserAgent("Testing"),
+		WithHeader("X-Api-Key", "secret"),
+		WithHeaders(http.Header{"Accept-Language": []string{"de"}}),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client: %s", err)
+	}
+
+	ctx := ContextWithHeaders(context.Background(), http.Header{"accept-language": []string{"fr"}})
+	ctx = ContextWithHeaders(ctx, http.Header{"X-Correlation-Id": []string{"42"}})
+
+	req, err := client.NewRequest(ctx, "locations?query=Bern")
+	if err != nil {
+		t.Fatalf("Failed to create request: %s", err)
+	}
+
+	testValues := []struct {
+		key  string
+		want string
+	}{
+		{"User-Agent", "Testing"},
+		{"X-Api-Key", "secret"},
+		{"Accept-Language", "fr"},
+		{"X-Correlation-Id", "42"},
+	}
+
+	for _, tv := range testValues {
+		if got := req.Header.Get(tv.key); got != tv.want {
+			t.Errorf("The request has the header %s: '%s' but want '%s'", tv.key, got, tv.want)
+		}
+	}
+
+	// Headers of a call do not leak into other calls
+	req, _ = client.NewRequest(context.Background(), "locations?query=Bern")
+	if got, want := req.Header.Get("Accept-Language"), "de"; got != want {
+		t.Errorf("The request has the header Accept-Language: '%s' but want '%s'", got, want)
+	}
+
+	if got := req.Header.Get("X-Correlation-Id"); got != "" {
+		t.Errorf("A per-call header was added to another call: %s", got)
+	}
+}
+
+func TestClient_DefaultUserAgentSent(t *testing.T) {
+	srv, client, terminate := prepare()
+	defer terminate()
+
+	var userAgent string
+	srv.HandleFunc("/locations", func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		_, _ = fmt.Fprint(w, `{"stations":[]}`)
+	})
+
+	// An empty per-call user agent does not remove the header
+	ctx := ContextWithHeaders(context.Background(), http.Header{"User-Agent": []string{""}})
+	if _, err := client.Location.Search(ctx, "Zürich"); err != nil {
+		t.Fatalf("Failed to search location: %s", err)
+	}
+
+	if got, want := userAgent, DefaultUserAgent; got != want {
+		t.Errorf("The server received the user agent '%s' but want '%s'", got, want)
+	}
+}
+
+func TestHeadersFromContext(t *testing.T) {
+	if h := HeadersFromContext(context.Background()); h != nil {
+		t.Errorf("A context without headers should return nil but got %v", h)
+	}
+
+	ctx := ContextWithHeaders(context.Background(), http.Header{"X-Test": []string{"1"}})
+
+	// The returned headers are a copy
+	HeadersFromContext(ctx).Set("X-Test", "2")
+	if got, want := HeadersFromContext(ctx).Get("X-Test"), "1"; got != want {
+		t.Errorf("The headers of the context were modified: got %s but want %s", got, want)
+	}
+}
@@ -0,0 +1,180 @@
+package opentransport
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// The default delay before a hedge request is sent
+const DefaultHedgeDelay = time.Second
+
+// The amount of recent latencies a Hedger keeps to calculate the percentile
+const hedgeWindow = 100
+
+// The minimum amount of latencies before the percentile is used instead of the fixed delay
+const hedgeMinSamples = 10
+
+// Configuration of a Hedger. Zero values are replaced by the defaults.
+type HedgeSettings struct {
+	Delay      time.Duration // The time to wait for the first request before a hedge request is sent. Default is DefaultHedgeDelay.
+	Percentile float64       // If set between 0 and 1, the delay is the percentile of recent latencies, e.g. 0.95. Delay is used until enough latencies are known.
+	Services   []Service     // The services which are hedged. Default are all services.
+}
+
+// A Hedger sends a second identical request, if the first one has not answered within a delay. The first
+// successful response wins and the other request is canceled. Hedge requests are only sent if the rate limiter
+// has budget left. It is safe for concurrent use and can be shared between clients.
+type Hedger struct {
+	mu        sync.Mutex
+	settings  HedgeSettings
+	latencies []time.Duration
+	next      int
+}
+
+// Metrics which implement HedgeMetrics additionally record hedge requests.
+type HedgeMetrics interface {
+	// Called for every hedge request. Won is true if the hedge request answered first.
+	ObserveHedge(service Service, won bool)
+}
+
+// Creates a new hedger.
+//
+// Returns a pointer to a Hedger
+func NewHedger(settings HedgeSettings) *Hedger {
+	if settings.Delay <= 0 {
+		settings.Delay = DefaultHedgeDelay
+	}
+	if settings.Percentile < 0 || settings.Percentile >= 1 {
+		settings.Percentile = 0
+	}
+	return &Hedger{settings: settings, latencies: make([]time.Duration, 0, hedgeWindow)}
+}
+
+// Sets a hedger for the requests of all services. If the hedger is nil, hedging is disabled.
+func WithHedging(hedger *Hedger) Option {
+	return func(cfg *clientConfig) error {
+		cfg.hedger = hedger
+		return nil
+	}
+}
+
+// Returns the current delay before a hedge request is sent
+func (h *Hedger) Delay() time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.settings.Percentile == 0 || len(h.latencies) < hedgeMinSamples {
+		return h.settings.Delay
+	}
+
+	sorted := append([]time.Duration(nil), h.latencies...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted[int(h.settings.Percentile*float64(len(sorted)))]
+}
+
+// Records the latency of a successful request. Only the latest latencies are kept.
+func (h *Hedger) observe(latency time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.latencies) < hedgeWindow {
+		h.latencies = append(h.latencies, latency)
+		return
+	}
+	h.latencies[h.next] = latency
+	h.next = (h.next + 1) % hedgeWindow
+}
+
+// Reports whether the requests of the service are hedged
+func (h *Hedger) hedges(service Service) bool {
+	if len(h.settings.Services) == 0 {
+		return true
+	}
+	for _, s := range h.settings.Services {
+		if s == service {
+			return true
+		}
+	}
+	return false
+}
+
+// The response of the first or the hedge request
+type hedgeResult struct {
+	body    []byte
+	err     error
+	hedged  bool
+	latency time.Duration // Measured from the start of this request, not the first one
+}
+
+// Sends an attempt and a hedge request, if the attempt has not answered within the delay of the hedger.
+// Streams are never hedged. If the first request fails before the delay, its error is returned without hedging.
+//
+// Returns the first successful response or the error of the first request
+func (c *Client) hedge(cfg *clientConfig, req *http.Request, service Service, attempt int, stream bool) ([]byte, io.ReadCloser, error) {
+	h := cfg.hedger
+	if h == nil || stream || !h.hedges(service) {
+		return c.failover(cfg, req, service, attempt, stream)
+	}
+
+	// The loser is canceled as soon as a response is returned
+	ctx, cancel := context.WithCancel(req.Context())
+	defer cancel()
+
+	start := time.Now()
+	results := make(chan hedgeResult, 2)
+	send := func(hedged bool) {
+		go func() {
+			sent := time.Now()
+			body, _, err := c.failover(cfg, req.WithContext(ctx), service, attempt, false)
+			results <- hedgeResult{body: body, err: err, hedged: hedged, latency: time.Since(sent)}
+		}()
+	}
+	send(false)
+
+	timer := time.NewTimer(h.Delay())
+	defer timer.Stop()
+
+	pending, hedged := 1, false
+	var firstErr error
+	for {
+		select {
+		case <-timer.C:
+			if cfg.limiter != nil && !cfg.limiter.Allow() {
+				cfg.logger.Log(LevelDebug, "hedge request skipped", "reason", "rate limit", "attempt", attempt)
+				continue
+			}
+			cfg.logger.Log(LevelDebug, "hedge request", "path", req.URL.Path, "attempt", attempt, "after", time.Since(start))
+			hedged = true
+			pending++
+			send(true)
+
+		case r := <-results:
+			pending--
+			if r.err == nil {
+				h.observe(r.latency)
+				if m, ok := cfg.metrics.(HedgeMetrics); ok && hedged {
+					m.ObserveHedge(service, r.hedged)
+				}
+				return r.body, nil, nil
+			}
+
+			if !r.hedged {
+				firstErr = r.err
+			}
+			if pending > 0 {
+				continue
+			}
+			if m, ok := cfg.metrics.(HedgeMetrics); ok && hedged {
+				m.ObserveHedge(service, false)
+			}
+			if firstErr == nil {
+				firstErr = r.err
+			}
+			return nil, nil, firstErr
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package opentransport
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// Registers a location handler, which blocks the first request until it is canceled or the timeout is reached
+func handleSlowFirstRequest(mux *http.ServeMux, timeout time.Duration, hits *int32, canceled chan struct{}) {
+	mux.HandleFunc("/locations", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(hits, 1) == 1 {
+			select {
+			case <-r.Context().Done():
+				close(canceled)
+				return
+			case <-time.After(timeout):
+			}
+		}
+		fixture, _ := readFixture("location_search")
+		_, _ = w.Write(fixture)
+	})
+}
+
+func TestClient_Hedging(t *testing.T) {
+	mux, c, teardown := prepare()
+	defer teardown()
+
+	var hits int32
+	canceled := make(chan struct{})
+	handleSlowFirstRequest(mux, 5*time.Second, &hits, canceled)
+
+	metrics := NewPrometheusMetrics()
+	hedger := NewHedger(HedgeSettings{Delay: 100 * time.Millisecond})
+	c = mustWith(t, c, WithMetrics(metrics), WithHedging(hedger))
+
+	start := time.Now()
+	if _, err := c.Location.Search(context.Background(), "Bern"); err != nil {
+		t.Fatalf("The hedge request should succeed: %s", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("The hedge request should answer before the slow request, but it took %s", elapsed)
+	}
+	if got := atomic.LoadInt32(&hits); got != 2 {
+		t.Errorf("Got %d requests instead of 2", got)
+	}
+
+	select {
+	case <-canceled:
+	case <-time.After(2 * time.Second):
+		t.Errorf("The slow request should be canceled")
+	}
+
+	// The latency of the winner is measured from its own start, not from the start of the slow request
+	hedger.mu.Lock()
+	latencies := append([]time.Duration(nil), hedger.latencies...)
+	hedger.mu.Unlock()
+	if len(latencies) != 1 || latencies[0] >= hedger.settings.Delay {
+		t.Errorf("Got the latencies %v instead of the latency of the hedge request", latencies)
+	}
+
+	// Give the canceled request time to finish
+	time.Sleep(50 * time.Millisecond)
+
+	var out bytes.Buffer
+	_ = metrics.WritePrometheus(&out)
+	if !strings.Contains(out.String(), `opentransport_hedges_total{service="location",result="won"} 1`) {
+		t.Errorf("The won hedge request is missing in the metrics:\n%s", out.String())
+	}
+	if !strings.Contains(out.String(), `opentransport_requests_total{service="location",code="2xx"} 1`) {
+		t.Errorf("The successful hedge request is missing in the metrics:\n%s", out.String())
+	}
+	if strings.Contains(out.String(), `code="error"`) {
+		t.Errorf("The canceled request should not be counted as error:\n%s", out.String())
+	}
+}
+
+func TestClient_HedgingRateLimit(t *testing.T) {
+	mux, c, teardown := prepare()
+	defer teardown()
+
+	var hits int32
+	handleSlowFirstRequest(mux, 100*time.Millisecond, &hits, make(chan struct{}))
+
+	// The budget is used by the first request, so the hedge request is skipped
+	c = mustWith(t, c,
+		WithRateLimiter(NewRateLimiter(RateLimit{PerDay: 1})),
+		WithHedging(NewHedger(HedgeSettings{Delay: 10 * time.Millisecond})),
+	)
+
+	if _, err := c.Location.Search(context.Background(), "Bern"); err != nil {
+		t.Fatalf("The first request should succeed: %s", err)
+	}
+	if got := atomic.LoadInt32(&hits); got != 1 {
+		t.Errorf("Got %d requests instead of 1, the hedge request should not exceed the quota", got)
+	}
+}
+
+func TestClient_HedgingServices(t *testing.T) {
+	mux, c, teardown := prepare()
+	defer teardown()
+
+	var hits int32
+	handleSlowFirstRequest(mux, 100*time.Millisecond, &hits, make(chan struct{}))
+
+	c = mustWith(t, c, WithHedging(NewHedger(HedgeSettings{Delay: 10 * time.Millisecond, Services: []Service{ServiceConnection}})))
+
+	if _, err := c.Location.Search(context.Background(), "Bern"); err != nil {
+		t.Fatalf("The request should succeed: %s", err)
+	}
+	if got := atomic.LoadInt32(&hits); got != 1 {
+		t.Errorf("Got %d requests instead of 1, the location service should not be hedged", got)
+	}
+}
+
+func TestHedger_Delay(t *testing.T) {
+	h := NewHedger(HedgeSettings{Percentile: 0.9})
+	if got := h.Delay(); got != DefaultHedgeDelay {
+		t.Errorf("Got delay %s instead of the default %s", got, DefaultHedgeDelay)
+	}
+
+	for i := 1; i <= hedgeMinSamples-1; i++ {
+		h.observe(time.Duration(i) * time.Millisecond)
+	}
+	if got := h.Delay(); got != DefaultHedgeDelay {
+		t.Errorf("With too few latencies, the delay should be the fixed delay but got %s", got)
+	}
+
+	// Only the latest latencies are kept
+	for i := 1; i <= 2*hedgeWindow; i++ {
+		h.observe(time.Duration(i) * time.Millisecond)
+	}
+	if got, want := h.Delay(), 191*time.Millisecond; got != want {
+		t.Errorf("Got delay %s instead of the percentile %s", got, want)
+	}
+
+	fixed := NewHedger(HedgeSettings{Delay: 50 * time.Millisecond})
+	fixed.observe(time.Second)
+	if got := fixed.Delay(); got != 50*time.Millisecond {
+		t.Errorf("Without percentile, the delay should be fixed but got %s", got)
+	}
+}
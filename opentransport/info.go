@@ -0,0 +1,168 @@
+package opentransport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// The version of the API the models of this library are built against
+const APIVersion = "1.0"
+
+// The time limit of the compatibility check during the creation of a client
+const DefaultCompatibilityTimeout = 5 * time.Second
+
+// Information about the API, returned by its root endpoint.
+type Info struct {
+	Date    time.Time `json:"date"`    // The time the response was generated by the API
+	Author  string    `json:"author"`  // The provider of the API (e.g. Opendata.ch)
+	Version string    `json:"version"` // The version of the API (e.g. 1.0)
+}
+
+// Provides access to the information about the API
+type InfoService struct {
+	client *Client
+}
+
+// Create a new InfoService.
+//
+// Returns a pointer to an InfoService
+func newInfoService(client *Client) *InfoService {
+	return &InfoService{client: client}
+}
+
+// Query the author, the date and the version of the API.
+//
+// Returns the information about the API and an error.
+func (s *InfoService) Info(ctx context.Context) (info *Info, err error) {
+	ctx, span := s.client.startSpan(ctx, SpanInfo)
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	req, err := s.client.NewRequest(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create info request: %w", err)
+	}
+
+	res, err := s.client.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.parseResponse(res.body)
+}
+
+// Checks if the API is available with a single request. The request is not repeated, not hedged,
+// not cached, not coalesced, not served by the offline store and not guarded by the circuit breaker.
+// The rate limiter is consulted before the latency is measured.
+//
+// Returns the round-trip latency and an error if the API is not available.
+func (s *InfoService) Ping(ctx context.Context) (latency time.Duration, err error) {
+	ctx, span := s.client.startSpan(ctx, SpanPing)
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	req, err := s.client.NewRequest(ctx, "")
+	if err != nil {
+		return 0, fmt.Errorf("failed to create ping request: %w", err)
+	}
+
+	cfg := s.client.config().clone()
+	cfg.retryPolicy = NoRetry
+	cfg.cache = nil
+	cfg.store = nil
+	cfg.coalesce = false
+	cfg.breaker = nil
+	cfg.hedger = nil
+
+	// The wait for a free budget is not part of the latency
+	if limiter := cfg.limiter; limiter != nil {
+		if err = limiter.Wait(ctx); err != nil {
+			return 0, err
+		}
+		cfg.limiter = nil
+	}
+
+	start := time.Now()
+	res, err := s.client.chain(cfg, req)
+	latency = time.Since(start)
+	if err != nil {
+		return latency, err
+	}
+
+	if _, err = s.parseResponse(res.body); err != nil {
+		return latency, err
+	}
+	return latency, nil
+}
+
+// Compares the version of the API with APIVersion, the version the models of this library are built against.
+// A warning is logged if the versions differ.
+//
+// Returns the information about the API and an error if the API could not be queried.
+func (s *InfoService) CheckCompatibility(ctx context.Context) (*Info, error) {
+	info, err := s.Info(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.Version != APIVersion {
+		s.client.log(LevelWarn, "api version differs from the models of the library",
+			"serverVersion", info.Version, "libraryVersion", APIVersion, "compatible", info.Compatible())
+	}
+	return info, nil
+}
+
+// Checks if the API has the same major version as APIVersion.
+//
+// Returns true if the models of the library are compatible with the API
+func (i *Info) Compatible() bool {
+	return majorVersion(i.Version) == majorVersion(APIVersion)
+}
+
+// Checks the compatibility of the API version during the creation of the client, see InfoService.CheckCompatibility.
+// The check is limited to DefaultCompatibilityTimeout. A failed check is logged and does not prevent the creation of the client.
+func WithCompatibilityCheck() Option {
+	return func(cfg *clientConfig) error {
+		cfg.compatibilityCheck = true
+		return nil
+	}
+}
+
+// Runs the compatibility check, if it is enabled in the config of the client
+func (c *Client) checkCompatibility() {
+	if !c.config().compatibilityCheck {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultCompatibilityTimeout)
+	defer cancel()
+
+	if _, err := c.Info.CheckCompatibility(ctx); err != nil {
+		c.log(LevelWarn, "failed to check the api version", "error", err)
+	}
+}
+
+// Parse a json raw response to an info type.
+//
+// Returns the information about the API and an error if the parsing failed.
+func (s *InfoService) parseResponse(raw []byte) (*Info, error) {
+	var info Info
+	if err := json.Unmarshal(raw, &info); err != nil {
+		return nil, fmt.Errorf("failed to parse info response: %w", err)
+	}
+
+	if len(info.Version) == 0 {
+		return nil, fmt.Errorf("failed to parse info response: the version is missing")
+	}
+	return &info, nil
+}
+
+// Returns the major part of a version, e.g. 1 of 1.0
+func majorVersion(version string) string {
+	return strings.SplitN(strings.TrimPrefix(version, "v"), ".", 2)[0]
+}
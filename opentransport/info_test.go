@@ -0,0 +1,148 @@
+package opentransport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestInfoService_Info(t *testing.T) {
+	_, client, teardown := prepare()
+	defer teardown()
+
+	info, err := client.Info.Info(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to query the api info: %s", err)
+	}
+
+	if got, want := info.Version, "1.0"; got != want {
+		t.Errorf("The api has the version %s but want %s", got, want)
+	}
+
+	if got, want := info.Author, "Opendata.ch"; got != want {
+		t.Errorf("The api has the author %s but want %s", got, want)
+	}
+
+	want := time.Date(2020, 4, 27, 0, 11, 57, 0, time.UTC)
+	if !info.Date.Equal(want) {
+		t.Errorf("The api has the date %s but want %s", info.Date, want)
+	}
+
+	if !info.Compatible() {
+		t.Errorf("The api version %s should be compatible with %s", info.Version, APIVersion)
+	}
+}
+
+func TestInfoService_Ping(t *testing.T) {
+	mux, client, teardown := prepare()
+	defer teardown()
+
+	if _, err := client.Info.Ping(context.Background()); err != nil {
+		t.Errorf("The ping should succeed: %s", err)
+	}
+
+	// A failed ping is not repeated and not served by the offline store
+	var calls int32
+	mux.HandleFunc("/v2/", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) > 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fixture, _ := readFixture("root")
+		_, _ = w.Write(fixture)
+	})
+
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	store, cleanup := newTestFileStore(t)
+	defer cleanup()
+	client, _ = New(WithBaseURL(ts.URL+"/v2/"), WithOfflineStore(store))
+
+	if _, err := client.Info.Info(context.Background()); err != nil {
+		t.Fatalf("Failed to query the api info: %s", err)
+	}
+
+	if _, err := client.Info.Ping(context.Background()); !errors.Is(err, ErrServerError) {
+		t.Errorf("The ping should fail with a server error but got %v", err)
+	}
+
+	if got, want := atomic.LoadInt32(&calls), int32(2); got != want {
+		t.Errorf("The api was queried %d times but want %d", got, want)
+	}
+}
+
+func TestInfoService_PingLatency(t *testing.T) {
+	_, client, teardown := prepare()
+	defer teardown()
+
+	client = mustWith(t, client, WithRateLimiter(NewRateLimiter(RateLimit{PerSecond: 1})))
+
+	// Exhaust the budget of the current second
+	if _, err := client.Info.Info(context.Background()); err != nil {
+		t.Fatalf("Failed to query the api info: %s", err)
+	}
+
+	start := time.Now()
+	latency, err := client.Info.Ping(context.Background())
+	if err != nil {
+		t.Fatalf("The ping should succeed: %s", err)
+	}
+
+	if elapsed := time.Since(start); latency > elapsed/2 {
+		t.Errorf("The latency of %s includes the wait for the rate limiter, the ping took %s", latency, elapsed)
+	}
+}
+
+func TestWithCompatibilityCheck(t *testing.T) {
+	testValues := []struct {
+		version string
+		warning bool
+	}{
+		{APIVersion, false},
+		{"1.1", true},
+		{"2.0", true},
+	}
+
+	for _, tv := range testValues {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"date":"2020-04-27T02:11:57+02:00","author":"Opendata.ch","version":"` + tv.version + `"}`))
+		}))
+
+		logger := &testLogger{}
+		if _, err := New(WithBaseURL(ts.URL), WithLogger(logger), WithCompatibilityCheck()); err != nil {
+			t.Errorf("The compatibility check should not prevent the creation of the client: %s", err)
+		}
+		ts.Close()
+
+		warnings := logger.find("api version differs from the models of the library")
+		if got := len(warnings) == 1; got != tv.warning {
+			t.Errorf("The version %s logged a warning %t but want %t", tv.version, got, tv.warning)
+			continue
+		}
+
+		if tv.warning {
+			compatible := strings.HasPrefix(tv.version, "1.")
+			if got := warnings[0].fields["compatible"]; got != compatible {
+				t.Errorf("The version %s is compatible %v but want %t", tv.version, got, compatible)
+			}
+		}
+	}
+}
+
+func TestWithCompatibilityCheck_Unavailable(t *testing.T) {
+	logger := &testLogger{}
+	_, err := New(WithBaseURL("http://127.0.0.1:1"), WithRetryPolicy(NoRetry), WithLogger(logger), WithCompatibilityCheck())
+	if err != nil {
+		t.Fatalf("An unavailable api should not prevent the creation of the client: %s", err)
+	}
+
+	if len(logger.find("failed to check the api version")) != 1 {
+		t.Errorf("The failed compatibility check should be logged")
+	}
+}
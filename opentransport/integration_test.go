@@ -4,31 +4,64 @@ package opentransport
 
 import (
 	"context"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/minderjan/opentransport-client/opentransportrecorder"
 )
 
+// The integration tests replay the cassettes in testdata/cassettes. Set the environment
+// variable OPENTRANSPORT_RECORD=1 to record them against the live API.
+const recordEnv = "OPENTRANSPORT_RECORD"
+
+// Creates a client which replays or records the cassette of a test. The returned function writes the
+// cassette in record mode. Tests without a cassette fail in replay mode.
+func newIntegrationClient(t *testing.T, name string) (*Client, func()) {
+	rec, err := opentransportrecorder.New(filepath.Join("testdata", "cassettes", name+".json"), opentransportrecorder.Options{
+		Mode: opentransportrecorder.ModeFromEnv(recordEnv),
+	})
+	if err != nil {
+		t.Fatalf("Missing cassette, record it with %s=1: %s", recordEnv, err)
+	}
+
+	opts := []Option{WithHTTPClient(rec.Client())}
+	if rec.Mode() == opentransportrecorder.ModeReplay {
+		opts = append(opts, WithRetryPolicy(NoRetry))
+	}
+
+	client, err := New(opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, func() {
+		if err := rec.Stop(); err != nil {
+			t.Errorf("Failed to write the cassette: %s", err)
+		}
+	}
+}
 
 func TestLocationIntegration(t *testing.T) {
-	client := NewClient()
+	client, stop := newIntegrationClient(t, "location")
+	defer stop()
 
 	locations, err := client.Location.SearchWithType(context.Background(), "Zürich HB", TypeAll)
 	if err != nil {
-		t.Errorf("Failed to search a location by name")
+		t.Fatalf("Failed to search a location by name: %s", err)
 	}
 
 	var zrh = Location{
 		Id:    "8503000",
 		Name:  "Zürich HB",
-		Score: 0,
+		Score: OptionalFloat{},
 		Coordinate: Coordinate{
 			Type: "WGS84",
-			X:    47.377847,
-			Y:    8.540502,
+			X:    NewOptionalFloat(47.377847),
+			Y:    NewOptionalFloat(8.540502),
 		},
-		Distance: 0,
+		Distance: OptionalInt{},
 		Icon:     "train",
 	}
 
@@ -39,7 +72,8 @@ func TestLocationIntegration(t *testing.T) {
 }
 
 func TestConnectionIntegration(t *testing.T) {
-	client := NewClient()
+	client, stop := newIntegrationClient(t, "connection")
+	defer stop()
 
 	from := "Zürich HB"
 	to := "Bern"
@@ -47,12 +81,11 @@ func TestConnectionIntegration(t *testing.T) {
 
 	connRes, err := client.Connection.Search(context.Background(), from, to, when)
 	if err != nil {
-		t.Errorf("Failed to search a connection from %s to %s at %s", from, to, when.Format("2006-01-02 15:04"))
-		t.Skipf("Further tests will be skipped because of an error during the query: %s", err)
+		t.Fatalf("Failed to search a connection from %s to %s at %s: %s", from, to, when.Format("2006-01-02 15:04"), err)
 	}
 
 	if len(connRes.Connections) == 0 {
-		t.Errorf("No connections found but expected at minimum one")
+		t.Fatalf("No connections found but expected at minimum one")
 	}
 
 	// Check if the first location match the expected location
@@ -72,7 +105,8 @@ func TestConnectionIntegration(t *testing.T) {
 }
 
 func TestStationboardIntegration(t *testing.T) {
-	client := NewClient()
+	client, stop := newIntegrationClient(t, "stationboard")
+	defer stop()
 
 	station := "Zürich HB"
 	when := time.Now()
@@ -86,8 +120,11 @@ func TestStationboardIntegration(t *testing.T) {
 
 	stbRes, err := client.Stationboard.SearchWithOpts(context.Background(), station, stbOpts)
 	if err != nil {
-		t.Errorf("Could not get Stationboard for %s: %s", station, err)
-		t.Skipf("Skip stationboard integration because of a query error: %s", err)
+		t.Fatalf("Could not get Stationboard for %s: %s", station, err)
+	}
+
+	if len(stbRes.Journeys) == 0 {
+		t.Fatalf("No journeys found but expected at minimum one")
 	}
 
 	// Check if the first location match the expected location
@@ -10,19 +10,19 @@ import (
 
 // The location represents a station, address or poi.
 type Location struct {
-	Id         string     `json:"id"`         // The id of the station
-	Name       string     `json:"name"`       // The location name
-	Score      float32    `json:"score"`      // The accuracy of the result
-	Coordinate Coordinate `json:"coordinate"` // The location coordinates
-	Distance   int        `json:"distance"`   // If search has been with coordinates, distance to original point in meters
-	Icon       string     `json:"icon"`       // Indicates if the location is a train, tram, bus, ship or cableway station
+	Id         string        `json:"id"`         // The id of the station
+	Name       string        `json:"name"`       // The location name
+	Score      OptionalFloat `json:"score"`      // The accuracy of the result. Unknown if the API does not score the result.
+	Coordinate Coordinate    `json:"coordinate"` // The location coordinates
+	Distance   OptionalInt   `json:"distance"`   // If search has been with coordinates, distance to original point in meters. Unknown otherwise.
+	Icon       string        `json:"icon"`       // Indicates if the location is a train, tram, bus, ship or cableway station
 }
 
 // The location coordinates.
 type Coordinate struct {
-	Type string  `json:"type"` // The type of the given coordinate
-	X    float64 `json:"x"`    // Latitude
-	Y    float64 `json:"y"`    // Longitude
+	Type string        `json:"type"` // The type of the given coordinate
+	X    OptionalFloat `json:"x"`    // Latitude, unknown for some addresses
+	Y    OptionalFloat `json:"y"`    // Longitude, unknown for some addresses
 }
 
 // The result returned by the API.
@@ -40,6 +40,12 @@ const (
 	TypeAddress LocationType = "address"
 )
 
+// Possible request options to search for a location
+type LocOpts struct {
+	Type   LocationType // The type of the locations, TypeAll, TypeStation, TypeAddress or TypePoi. Defaults to TypeAll.
+	Fields []Field      // Limits the attributes of the response, e.g. StationsName. Default are all attributes.
+}
+
 // Provides access to query locations
 type LocationService struct {
 	client *Client
@@ -70,8 +76,26 @@ func (s *LocationService) Search(ctx context.Context, name string) ([]Location,
 //
 // Returns an array with locations and an error.
 func (s *LocationService) SearchWithType(ctx context.Context, name string, locationType LocationType) ([]Location, error) {
-	path := fmt.Sprintf("locations?query=%s&type=%s", url.PathEscape(name), locationType)
-	return s.query(ctx, path)
+	return s.SearchWithOpts(ctx, name, LocOpts{Type: locationType})
+}
+
+// Search for a specific address, poi or station by a name.
+// You can provide the location type and the attributes of the response within a LocOpts type.
+//
+// Returns an array with locations and an error.
+func (s *LocationService) SearchWithOpts(ctx context.Context, name string, opts LocOpts) ([]Location, error) {
+	locationType := opts.Type
+	if len(locationType) == 0 {
+		locationType = TypeAll
+	}
+
+	fields, err := convFields(opts.Fields, LocationResult{})
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("locations?query=%s&type=%s%s", url.PathEscape(name), locationType, fields)
+	return s.query(ctx, path, Attr(AttrQuery, name))
 }
 
 // Search for a specific address, poi or station by lat / long coordinates.
@@ -80,11 +104,17 @@ func (s *LocationService) SearchWithType(ctx context.Context, name string, locat
 // Returns an array with locations and an error.
 func (s *LocationService) SearchWithCoordinates(ctx context.Context, lat float64, long float64) ([]Location, error) {
 	path := fmt.Sprintf("locations?x=%f&y=%f", lat, long)
-	return s.query(ctx, path)
+	return s.query(ctx, path, Attr(AttrQuery, fmt.Sprintf("%f,%f", lat, long)))
 }
 
-// Runs a location query and returns a list of locations
-func (s *LocationService) query(ctx context.Context, path string) ([]Location, error) {
+// Runs a location query within a span with the attributes and returns a list of locations
+func (s *LocationService) query(ctx context.Context, path string, attrs ...Attribute) (locations []Location, err error) {
+	ctx, span := s.client.startSpan(ctx, SpanLocationSearch, attrs...)
+	defer func() {
+		span.SetAttributes(Attr(AttrResultCount, len(locations)))
+		endSpan(span, err)
+	}()
+
 	if len(path) == 0 {
 		return nil, errors.New("the request path can not be empty")
 	}
@@ -94,12 +124,12 @@ func (s *LocationService) query(ctx context.Context, path string) ([]Location, e
 		return nil, fmt.Errorf("failed to create location request: %w", err)
 	}
 
-	res, err := s.client.Do(req)
+	res, err := s.client.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to proceed request: %w", err)
 	}
 
-	locResult, err := s.parseResponse(res)
+	locResult, err := s.parseResponse(res.body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse location response: %w", err)
 	}
@@ -118,7 +148,7 @@ func (s *LocationService) parseResponse(raw []byte) (*LocationResult, error) {
 	var locResp LocationResult
 	err := json.Unmarshal(raw, &locResp)
 
-	s.client.debug.Printf("Parse location response to a typed object")
+	s.client.log(LevelDebug, "parsed location response", "bytes", len(raw))
 
 	return &locResp, err
 }
@@ -84,6 +84,19 @@ func TestLocationService_SearchWithCoordinates(t *testing.T) {
 		t.Errorf("No location objects were returned")
 	}
 
+	if got := locations[0].Distance; !got.Valid() || got.Value() != 10 {
+		t.Errorf("Got distance %s instead of 10", got)
+	}
+	if got := locations[0].Score; got.Valid() {
+		t.Errorf("The score null was decoded as %s", got)
+	}
+	if got := locations[0].Coordinate; got.X.Valid() || got.Y.Valid() {
+		t.Errorf("The coordinate null was decoded as %s/%s", got.X, got.Y)
+	}
+	if got := locations[1].Coordinate.X; got.Value() != 47.47561 {
+		t.Errorf("Got latitude %s instead of 47.47561", got)
+	}
+
 	// check the returned struct against a static struct
 	var staticResult LocationResult
 	_ = json.Unmarshal(fixture, &staticResult)
@@ -141,13 +154,13 @@ func TestLocation_Station(t *testing.T) {
 	address := Location{
 		Id:    "",
 		Name:  "China Garden, Zermatt, Bahnhofstr. 18",
-		Score: 0,
+		Score: OptionalFloat{},
 		Coordinate: Coordinate{
 			Type: "WGS84",
-			X:    0,
-			Y:    0,
+			X:    OptionalFloat{},
+			Y:    OptionalFloat{},
 		},
-		Distance: 0,
+		Distance: OptionalInt{},
 		Icon:     "",
 	}
 
@@ -158,13 +171,13 @@ func TestLocation_Station(t *testing.T) {
 	station := Location{
 		Id:    "8503000",
 		Name:  "Zürich HB",
-		Score: 0,
+		Score: OptionalFloat{},
 		Coordinate: Coordinate{
 			Type: "WGS84",
-			X:    47.377847,
-			Y:    8.540502,
+			X:    NewOptionalFloat(47.377847),
+			Y:    NewOptionalFloat(8.540502),
 		},
-		Distance: 0,
+		Distance: OptionalInt{},
 		Icon:     "train",
 	}
 
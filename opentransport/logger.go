@@ -0,0 +1,140 @@
+package opentransport
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The severity of a log message
+type Level int
+
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+// A Logger writes leveled log messages with structured fields. The fields are passed as
+// alternating keys and values, e.g. Log(LevelDebug, "http request", "status", 200, "bytes", 512).
+// Implementations have to be safe for concurrent use.
+type Logger interface {
+	Log(level Level, msg string, keyvals ...interface{})
+}
+
+// A logger which discards all messages. It is used if logging is disabled.
+type nopLogger struct{}
+
+// A logger which writes to the log package of the standard library. Debug and info messages
+// are written to the debug logger, warnings and errors to the error logger.
+type stdLogger struct {
+	debug *log.Logger
+	error *log.Logger
+	min   Level
+}
+
+// Returns the name of the level in lower case
+func (l Level) String() string {
+	switch l {
+	case LevelDebug:
+		return "debug"
+	case LevelInfo:
+		return "info"
+	case LevelWarn:
+		return "warn"
+	case LevelError:
+		return "error"
+	}
+	return fmt.Sprintf("level(%d)", int(l))
+}
+
+// Creates a logger which writes all messages with at least the min level to a logger of the standard
+// library. The fields are formatted as key=value pairs, e.g.
+//
+//	level=debug msg="http request" method=GET path=locations?query=Bern status=200
+//
+// Returns a Logger
+func NewStdLogger(logger *log.Logger, min Level) Logger {
+	return &stdLogger{debug: logger, error: logger, min: min}
+}
+
+// Creates the logger used by EnableLogs, which writes debug and info messages to one output
+// and warnings and errors to another output.
+func newStdLoggers(debugOut io.Writer, errorOut io.Writer) *stdLogger {
+	return &stdLogger{
+		debug: log.New(debugOut, "Debug:\t", log.Ldate|log.Ltime),
+		error: log.New(errorOut, "Error:\t", log.Ldate|log.Ltime),
+		min:   LevelDebug,
+	}
+}
+
+// Discards the message
+func (nopLogger) Log(level Level, msg string, keyvals ...interface{}) {}
+
+// Writes the message with its fields to the logger of the level
+func (l *stdLogger) Log(level Level, msg string, keyvals ...interface{}) {
+	if level < l.min {
+		return
+	}
+
+	out := l.debug
+	if level >= LevelWarn {
+		out = l.error
+	}
+	out.Print(formatFields(level, msg, keyvals))
+}
+
+// Formats a log message and its fields as key=value pairs.
+//
+// Returns the formatted line
+func formatFields(level Level, msg string, keyvals []interface{}) string {
+	var b strings.Builder
+	b.WriteString("level=")
+	b.WriteString(level.String())
+	b.WriteString(" msg=")
+	b.WriteString(formatValue(msg))
+
+	for i := 0; i < len(keyvals); i += 2 {
+		key := fmt.Sprint(keyvals[i])
+		var value interface{} = "(MISSING)"
+		if i+1 < len(keyvals) {
+			value = keyvals[i+1]
+		}
+		b.WriteString(" ")
+		b.WriteString(key)
+		b.WriteString("=")
+		b.WriteString(formatValue(value))
+	}
+	return b.String()
+}
+
+// Formats a single value of a field. Values with spaces or quotes are quoted.
+func formatValue(v interface{}) string {
+	var s string
+	switch value := v.(type) {
+	case string:
+		s = value
+	case time.Duration:
+		s = value.String()
+	case time.Time:
+		s = value.Format(time.RFC3339)
+	case error:
+		s = value.Error()
+	default:
+		s = fmt.Sprint(value)
+	}
+
+	if len(s) == 0 || strings.ContainsAny(s, " \"=\t\n") {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+// Writes a log message to the logger of the current configuration
+func (c *Client) log(level Level, msg string, keyvals ...interface{}) {
+	c.config().logger.Log(level, msg, keyvals...)
+}
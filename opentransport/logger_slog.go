@@ -0,0 +1,50 @@
+//go:build go1.21
+// +build go1.21
+
+package opentransport
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// A logger which passes all messages to a slog.Handler
+type slogLogger struct {
+	handler slog.Handler
+}
+
+// Creates a logger which writes all messages with their fields as attributes to a slog.Handler,
+// e.g. a slog.JSONHandler. The levels are mapped to the corresponding slog levels and the
+// handler decides which levels are enabled.
+//
+// Returns a Logger
+func NewSlogLogger(handler slog.Handler) Logger {
+	return &slogLogger{handler: handler}
+}
+
+// Creates a slog record of the message and passes it to the handler
+func (l *slogLogger) Log(level Level, msg string, keyvals ...interface{}) {
+	ctx := context.Background()
+	sl := slogLevel(level)
+	if !l.handler.Enabled(ctx, sl) {
+		return
+	}
+
+	r := slog.NewRecord(time.Now(), sl, msg, 0)
+	r.Add(keyvals...)
+	_ = l.handler.Handle(ctx, r)
+}
+
+// Maps a level to the corresponding slog level
+func slogLevel(level Level) slog.Level {
+	switch level {
+	case LevelDebug:
+		return slog.LevelDebug
+	case LevelInfo:
+		return slog.LevelInfo
+	case LevelWarn:
+		return slog.LevelWarn
+	}
+	return slog.LevelError
+}
@@ -0,0 +1,41 @@
+//go:build go1.21
+// +build go1.21
+
+package opentransport
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+)
+
+func TestNewSlogLogger(t *testing.T) {
+	var out bytes.Buffer
+	logger := NewSlogLogger(slog.NewJSONHandler(&out, &slog.HandlerOptions{Level: slog.LevelInfo}))
+
+	logger.Log(LevelDebug, "hidden")
+	if out.Len() != 0 {
+		t.Errorf("Disabled levels should be discarded but got %q", out.String())
+	}
+
+	logger.Log(LevelWarn, "http request", "status", 503, "latency", time.Second)
+
+	var record map[string]interface{}
+	if err := json.Unmarshal(out.Bytes(), &record); err != nil {
+		t.Fatalf("The handler wrote invalid json: %s", err)
+	}
+
+	if got, want := record["level"], "WARN"; got != want {
+		t.Errorf("The record has the level %v but want %v", got, want)
+	}
+
+	if got, want := record["msg"], "http request"; got != want {
+		t.Errorf("The record has the message %v but want %v", got, want)
+	}
+
+	if got, want := record["status"], float64(503); got != want {
+		t.Errorf("The record has the status %v but want %v", got, want)
+	}
+}
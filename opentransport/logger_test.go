@@ -0,0 +1,128 @@
+package opentransport
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// A logger which records all messages for tests
+type testLogger struct {
+	mu      sync.Mutex
+	entries []testLogEntry
+}
+
+type testLogEntry struct {
+	level  Level
+	msg    string
+	fields map[string]interface{}
+}
+
+func (l *testLogger) Log(level Level, msg string, keyvals ...interface{}) {
+	fields := make(map[string]interface{})
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		fields[keyvals[i].(string)] = keyvals[i+1]
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, testLogEntry{level: level, msg: msg, fields: fields})
+}
+
+// Returns all entries with the message
+func (l *testLogger) find(msg string) []testLogEntry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var found []testLogEntry
+	for _, e := range l.entries {
+		if e.msg == msg {
+			found = append(found, e)
+		}
+	}
+	return found
+}
+
+func TestNewStdLogger(t *testing.T) {
+	var out bytes.Buffer
+	logger := NewStdLogger(log.New(&out, "", 0), LevelInfo)
+
+	logger.Log(LevelDebug, "hidden")
+	if out.Len() != 0 {
+		t.Errorf("Messages below the min level should be discarded but got %q", out.String())
+	}
+
+	logger.Log(LevelWarn, "http request", "path", "/locations", "latency", 2*time.Second, "error", errors.New("no route"), "odd")
+
+	want := `level=warn msg="http request" path=/locations latency=2s error="no route" odd=(MISSING)` + "\n"
+	if got := out.String(); got != want {
+		t.Errorf("The std logger wrote %q but want %q", got, want)
+	}
+}
+
+func TestLevel_String(t *testing.T) {
+	testValues := map[Level]string{
+		LevelDebug: "debug",
+		LevelInfo:  "info",
+		LevelWarn:  "warn",
+		LevelError: "error",
+		Level(9):   "level(9)",
+	}
+
+	for level, want := range testValues {
+		if got := level.String(); got != want {
+			t.Errorf("The level %d has the name %s but want %s", int(level), got, want)
+		}
+	}
+}
+
+func TestClient_RequestLogFields(t *testing.T) {
+	mux, client, teardown := prepare()
+	defer teardown()
+
+	mux.HandleFunc("/locations", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"stations":[]}`))
+	})
+
+	logger := &testLogger{}
+	client, err := client.With(WithLogger(logger))
+	if err != nil {
+		t.Fatalf("Failed to configure the logger: %s", err)
+	}
+
+	if _, err := client.Location.Search(context.Background(), "Bern"); err != nil {
+		t.Fatalf("Failed to search a location: %s", err)
+	}
+
+	entries := logger.find("http request")
+	if len(entries) != 1 {
+		t.Fatalf("The request was logged %d times but want 1", len(entries))
+	}
+
+	fields := entries[0].fields
+	for key, want := range map[string]interface{}{"method": "GET", "path": "/locations", "status": 200, "attempt": 1, "bytes": 15} {
+		if got := fields[key]; got != want {
+			t.Errorf("The field %s is %v but want %v", key, got, want)
+		}
+	}
+
+	if _, ok := fields["latency"].(time.Duration); !ok {
+		t.Errorf("The latency should be logged as duration but got %v", fields["latency"])
+	}
+}
+
+func TestClient_EnableLogsReplacesLogger(t *testing.T) {
+	var out bytes.Buffer
+	client, _ := New(WithLogger(&testLogger{}))
+	client.EnableLogs(&out)
+
+	if !strings.Contains(out.String(), `msg="client configured"`) {
+		t.Errorf("EnableLogs should log the configuration but got %q", out.String())
+	}
+}
@@ -0,0 +1,280 @@
+package opentransport
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Metrics records the activity of a client per service. Implementations have to be safe for concurrent use.
+// Use NewPrometheusMetrics for an in-process implementation with a Prometheus exporter.
+type Metrics interface {
+	// Called after every http attempt. The status is 0 if no response was received.
+	// The size is -1 if it is unknown, e.g. for streamed responses.
+	ObserveRequest(service Service, status int, latency time.Duration, size int)
+
+	// Called before a failed request is repeated.
+	ObserveRetry(service Service)
+
+	// Called for every lookup in the cache of the client.
+	ObserveCache(service Service, hit bool)
+}
+
+// Metrics which implement BreakerMetrics additionally record the state of the circuit breaker.
+type BreakerMetrics interface {
+	// Called with the initial state when a client is created and after every change of the circuit state.
+	ObserveBreakerState(state CircuitState)
+}
+
+// Metrics which are not recorded. They are used if no metrics are configured.
+type nopMetrics struct{}
+
+// Default buckets of the latency histogram in seconds
+var DefaultLatencyBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// Default buckets of the response size histogram in bytes
+var DefaultSizeBuckets = []float64{256, 1024, 4096, 16384, 65536, 262144, 1048576}
+
+// In-process metrics, which can be exported in the Prometheus text exposition format.
+// PrometheusMetrics is a http.Handler, which serves the metrics, e.g. on /metrics.
+type PrometheusMetrics struct {
+	mu       sync.Mutex
+	requests map[[2]string]uint64 // Keyed by service and status class
+	retries  map[string]uint64
+	cache    map[[2]string]uint64 // Keyed by service and result
+	latency  map[string]*histogram
+	size     map[string]*histogram
+	breaker  *CircuitState
+	hedges   map[[2]string]uint64 // Keyed by service and result
+}
+
+// A cumulative histogram with fixed buckets
+type histogram struct {
+	buckets []float64
+	counts  []uint64
+	sum     float64
+	count   uint64
+}
+
+// Creates new in-process metrics with the default buckets.
+//
+// Returns a pointer to PrometheusMetrics
+func NewPrometheusMetrics() *PrometheusMetrics {
+	return &PrometheusMetrics{
+		requests: make(map[[2]string]uint64),
+		retries:  make(map[string]uint64),
+		cache:    make(map[[2]string]uint64),
+		latency:  make(map[string]*histogram),
+		size:     make(map[string]*histogram),
+		hedges:   make(map[[2]string]uint64),
+	}
+}
+
+// Sets the metrics which record the requests of all services. If the metrics are nil, no metrics are recorded.
+func WithMetrics(metrics Metrics) Option {
+	return func(cfg *clientConfig) error {
+		cfg.metrics = metrics
+		return nil
+	}
+}
+
+// Discards the observation
+func (nopMetrics) ObserveRequest(service Service, status int, latency time.Duration, size int) {}
+
+// Discards the observation
+func (nopMetrics) ObserveRetry(service Service) {}
+
+// Discards the observation
+func (nopMetrics) ObserveCache(service Service, hit bool) {}
+
+// Counts the request by its status class and records its latency and response size.
+func (m *PrometheusMetrics) ObserveRequest(service Service, status int, latency time.Duration, size int) {
+	s := serviceLabel(service)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.requests[[2]string{s, statusClass(status)}]++
+	observe(m.latency, s, DefaultLatencyBuckets, latency.Seconds())
+	if status != 0 && size >= 0 {
+		observe(m.size, s, DefaultSizeBuckets, float64(size))
+	}
+}
+
+// Counts a retry of the service.
+func (m *PrometheusMetrics) ObserveRetry(service Service) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.retries[serviceLabel(service)]++
+}
+
+// Counts a cache hit or miss of the service.
+func (m *PrometheusMetrics) ObserveCache(service Service, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.cache[[2]string{serviceLabel(service), result}]++
+}
+
+// Records the current state of the circuit breaker.
+func (m *PrometheusMetrics) ObserveBreakerState(state CircuitState) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.breaker = &state
+}
+
+// Counts a hedge request of the service by its result.
+func (m *PrometheusMetrics) ObserveHedge(service Service, won bool) {
+	result := "lost"
+	if won {
+		result = "won"
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hedges[[2]string{serviceLabel(service), result}]++
+}
+
+// Serves the metrics in the Prometheus text exposition format.
+func (m *PrometheusMetrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_ = m.WritePrometheus(w)
+}
+
+// Writes all metrics in the Prometheus text exposition format.
+//
+// Returns an error if writing failed
+func (m *PrometheusMetrics) WritePrometheus(out io.Writer) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	w := bufio.NewWriter(out)
+
+	writeHeader(w, "opentransport_requests_total", "counter", "The amount of http requests to the API by service and status class.")
+	for _, k := range sortedPairs(m.requests) {
+		fmt.Fprintf(w, "opentransport_requests_total{service=%q,code=%q} %d\n", k[0], k[1], m.requests[k])
+	}
+
+	writeHeader(w, "opentransport_retries_total", "counter", "The amount of repeated http requests by service.")
+	for _, s := range sortedKeys(m.retries) {
+		fmt.Fprintf(w, "opentransport_retries_total{service=%q} %d\n", s, m.retries[s])
+	}
+
+	writeHeader(w, "opentransport_cache_requests_total", "counter", "The amount of cache lookups by service and result.")
+	for _, k := range sortedPairs(m.cache) {
+		fmt.Fprintf(w, "opentransport_cache_requests_total{service=%q,result=%q} %d\n", k[0], k[1], m.cache[k])
+	}
+
+	if m.breaker != nil {
+		writeHeader(w, "opentransport_circuit_state", "gauge", "The state of the circuit breaker, the current state is 1.")
+		for _, s := range []CircuitState{CircuitClosed, CircuitOpen, CircuitHalfOpen} {
+			fmt.Fprintf(w, "opentransport_circuit_state{state=%q} %d\n", s, boolToInt(s == *m.breaker))
+		}
+	}
+
+	if len(m.hedges) > 0 {
+		writeHeader(w, "opentransport_hedges_total", "counter", "The amount of hedge requests by service and result.")
+		for _, k := range sortedPairs(m.hedges) {
+			fmt.Fprintf(w, "opentransport_hedges_total{service=%q,result=%q} %d\n", k[0], k[1], m.hedges[k])
+		}
+	}
+
+	writeHeader(w, "opentransport_request_duration_seconds", "histogram", "The latency of http requests by service.")
+	writeHistograms(w, "opentransport_request_duration_seconds", m.latency)
+
+	writeHeader(w, "opentransport_response_size_bytes", "histogram", "The size of response bodies by service.")
+	writeHistograms(w, "opentransport_response_size_bytes", m.size)
+
+	return w.Flush()
+}
+
+// Adds a value to the histogram of the service, which is created if it does not exist
+func observe(histograms map[string]*histogram, service string, buckets []float64, value float64) {
+	h, ok := histograms[service]
+	if !ok {
+		h = &histogram{buckets: buckets, counts: make([]uint64, len(buckets))}
+		histograms[service] = h
+	}
+
+	for i, le := range h.buckets {
+		if value <= le {
+			h.counts[i]++
+		}
+	}
+	h.sum += value
+	h.count++
+}
+
+// Writes the help and type lines of a metric
+func writeHeader(w io.Writer, name string, kind string, help string) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+// Writes the buckets, the sum and the count of all histograms
+func writeHistograms(w io.Writer, name string, histograms map[string]*histogram) {
+	services := make([]string, 0, len(histograms))
+	for s := range histograms {
+		services = append(services, s)
+	}
+	sort.Strings(services)
+
+	for _, s := range services {
+		h := histograms[s]
+		for i, le := range h.buckets {
+			fmt.Fprintf(w, "%s_bucket{service=%q,le=%q} %d\n", name, s, strconv.FormatFloat(le, 'g', -1, 64), h.counts[i])
+		}
+		fmt.Fprintf(w, "%s_bucket{service=%q,le=\"+Inf\"} %d\n", name, s, h.count)
+		fmt.Fprintf(w, "%s_sum{service=%q} %s\n", name, s, strconv.FormatFloat(h.sum, 'g', -1, 64))
+		fmt.Fprintf(w, "%s_count{service=%q} %d\n", name, s, h.count)
+	}
+}
+
+// Returns the keys of a counter map in sorted order
+func sortedKeys(m map[string]uint64) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Returns the keys of a counter map with two labels in sorted order
+func sortedPairs(m map[[2]string]uint64) [][2]string {
+	keys := make([][2]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i][0] != keys[j][0] {
+			return keys[i][0] < keys[j][0]
+		}
+		return keys[i][1] < keys[j][1]
+	})
+	return keys
+}
+
+// Returns the status class of a http status, e.g. 2xx, or error if no response was received
+func statusClass(status int) string {
+	if status < 100 || status > 599 {
+		return "error"
+	}
+	return strconv.Itoa(status/100) + "xx"
+}
+
+// Returns the label of a service. Requests of unknown services are labeled as other.
+func serviceLabel(service Service) string {
+	if len(service) == 0 {
+		return "other"
+	}
+	return string(service)
+}
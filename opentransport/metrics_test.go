@@ -0,0 +1,120 @@
+package opentransport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPrometheusMetrics_Write(t *testing.T) {
+	m := NewPrometheusMetrics()
+	m.ObserveRequest(ServiceLocation, 200, 30*time.Millisecond, 2000)
+	m.ObserveRequest(ServiceLocation, 503, 2*time.Second, 0)
+	m.ObserveRequest(ServiceStationboard, 0, time.Second, 0)
+	m.ObserveRetry(ServiceLocation)
+	m.ObserveCache(ServiceLocation, true)
+	m.ObserveCache("", false)
+
+	rec := httptest.NewRecorder()
+	m.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	out := rec.Body.String()
+
+	if got := rec.Header().Get("Content-Type"); !strings.HasPrefix(got, "text/plain; version=0.0.4") {
+		t.Errorf("The metrics are served with the content type %s", got)
+	}
+
+	want := []string{
+		"# TYPE opentransport_requests_total counter",
+		`opentransport_requests_total{service="location",code="2xx"} 1`,
+		`opentransport_requests_total{service="location",code="5xx"} 1`,
+		`opentransport_requests_total{service="stationboard",code="error"} 1`,
+		`opentransport_retries_total{service="location"} 1`,
+		`opentransport_cache_requests_total{service="location",result="hit"} 1`,
+		`opentransport_cache_requests_total{service="other",result="miss"} 1`,
+		"# TYPE opentransport_request_duration_seconds histogram",
+		`opentransport_request_duration_seconds_bucket{service="location",le="0.05"} 1`,
+		`opentransport_request_duration_seconds_bucket{service="location",le="2.5"} 2`,
+		`opentransport_request_duration_seconds_bucket{service="location",le="+Inf"} 2`,
+		`opentransport_request_duration_seconds_sum{service="location"} 2.03`,
+		`opentransport_request_duration_seconds_count{service="location"} 2`,
+		`opentransport_response_size_bytes_bucket{service="location",le="1024"} 1`,
+		`opentransport_response_size_bytes_bucket{service="location",le="4096"} 2`,
+		`opentransport_response_size_bytes_count{service="location"} 2`,
+	}
+
+	for _, w := range want {
+		if !strings.Contains(out, w+"\n") {
+			t.Errorf("The metrics do not contain the line %s", w)
+		}
+	}
+
+	// Requests without a response have no size
+	if strings.Contains(out, `opentransport_response_size_bytes_count{service="stationboard"}`) {
+		t.Errorf("Failed requests should not be recorded as response size")
+	}
+}
+
+func TestClient_Metrics(t *testing.T) {
+	mux, client, teardown := prepare()
+	defer teardown()
+
+	var calls int32
+	mux.HandleFunc("/locations", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"stations":[]}`))
+	})
+
+	m := NewPrometheusMetrics()
+	client = mustWith(t, client,
+		WithMetrics(m),
+		WithRetryPolicy(ConstantBackoff{Attempts: 2, Pause: time.Millisecond}),
+		WithCache(NewLRUCache(10), nil),
+	)
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.Location.Search(context.Background(), "Bern"); err != nil {
+			t.Fatalf("Failed to search a location: %s", err)
+		}
+	}
+
+	var out strings.Builder
+	if err := m.WritePrometheus(&out); err != nil {
+		t.Fatalf("Failed to write the metrics: %s", err)
+	}
+
+	for _, w := range []string{
+		`opentransport_requests_total{service="location",code="2xx"} 1`,
+		`opentransport_requests_total{service="location",code="5xx"} 1`,
+		`opentransport_retries_total{service="location"} 1`,
+		`opentransport_cache_requests_total{service="location",result="hit"} 1`,
+		`opentransport_cache_requests_total{service="location",result="miss"} 1`,
+		`opentransport_response_size_bytes_sum{service="location"} 15`,
+	} {
+		if !strings.Contains(out.String(), w+"\n") {
+			t.Errorf("The metrics do not contain the line %s", w)
+		}
+	}
+}
+
+func TestClient_MetricsNil(t *testing.T) {
+	mux, client, teardown := prepare()
+	defer teardown()
+
+	mux.HandleFunc("/locations", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"stations":[]}`))
+	})
+
+	client = mustWith(t, client, WithMetrics(NewPrometheusMetrics()))
+	client = mustWith(t, client, WithMetrics(nil))
+
+	if _, err := client.Location.Search(context.Background(), "Bern"); err != nil {
+		t.Errorf("Failed to search a location without metrics: %s", err)
+	}
+}
@@ -0,0 +1,89 @@
+package opentransport
+
+import (
+	"net/http"
+	"time"
+)
+
+// A Doer performs a request to the API and returns the raw response body. The Client itself is a Doer.
+type Doer interface {
+	Do(req *http.Request) ([]byte, error)
+}
+
+// An adapter to use an ordinary function as Doer.
+type DoerFunc func(req *http.Request) ([]byte, error)
+
+// A Middleware wraps a Doer with additional behaviour, e.g. authentication, correlation ids,
+// metrics or fault injection. A middleware sees the request created by NewRequest and the raw
+// response body or error, before the services parse it. It wraps the whole request, including
+// the cache, the retries and the offline mode.
+//
+//	client, err := opentransport.New(opentransport.WithMiddleware(func(next opentransport.Doer) opentransport.Doer {
+//		return opentransport.DoerFunc(func(req *http.Request) ([]byte, error) {
+//			req.Header.Set("X-Correlation-Id", correlationID(req.Context()))
+//			return next.Do(req)
+//		})
+//	}))
+//
+// A middleware may answer a request without calling the next Doer.
+type Middleware func(next Doer) Doer
+
+// Calls f(req)
+func (f DoerFunc) Do(req *http.Request) ([]byte, error) {
+	return f(req)
+}
+
+// Adds middlewares to the client. The first middleware is the outermost one, which sees the
+// request first and the response last. Nil middlewares are ignored.
+func WithMiddleware(middlewares ...Middleware) Option {
+	return func(cfg *clientConfig) error {
+		cfg.middlewares = appendMiddlewares(cfg.middlewares, middlewares)
+		return nil
+	}
+}
+
+// Returns a new slice with the existing and the additional middlewares, without nil values
+func appendMiddlewares(existing []Middleware, additional []Middleware) []Middleware {
+	all := make([]Middleware, 0, len(existing)+len(additional))
+	all = append(all, existing...)
+	for _, m := range additional {
+		if m != nil {
+			all = append(all, m)
+		}
+	}
+	return all
+}
+
+// Runs the request through the middlewares of the config. The innermost Doer performs the actual request.
+//
+// Returns the response with its metadata. If a middleware answered the request itself or replaced
+// the body, the returned response contains that body.
+func (c *Client) chain(cfg *clientConfig, req *http.Request) (*response, error) {
+	if len(cfg.middlewares) == 0 {
+		return c.exec(cfg, req)
+	}
+
+	var last *response
+	var next Doer = DoerFunc(func(r *http.Request) ([]byte, error) {
+		res, err := c.exec(cfg, r)
+		if err != nil {
+			return nil, err
+		}
+		last = res
+		return res.body, nil
+	})
+
+	for i := len(cfg.middlewares) - 1; i >= 0; i-- {
+		next = cfg.middlewares[i](next)
+	}
+
+	body, err := next.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if last == nil {
+		return &response{body: body, fetchedAt: time.Now()}, nil
+	}
+	return &response{body: body, fetchedAt: last.fetchedAt, stale: last.stale}, nil
+}
@@ -0,0 +1,116 @@
+package opentransport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClient_UseOrder(t *testing.T) {
+	mux, client, teardown := prepare()
+	defer teardown()
+
+	mux.HandleFunc("/locations", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"stations":[]}`))
+	})
+
+	var calls []string
+	trace := func(name string) Middleware {
+		return func(next Doer) Doer {
+			return DoerFunc(func(req *http.Request) ([]byte, error) {
+				calls = append(calls, name+" request")
+				body, err := next.Do(req)
+				calls = append(calls, name+" response")
+				return body, err
+			})
+		}
+	}
+
+	client = mustWith(t, client, WithMiddleware(trace("outer"), nil, trace("inner")))
+
+	if _, err := client.Location.Search(context.Background(), "Bern"); err != nil {
+		t.Fatalf("Failed to search a location: %s", err)
+	}
+
+	want := "outer request,inner request,inner response,outer response"
+	if got := strings.Join(calls, ","); got != want {
+		t.Errorf("The middlewares were called in the order %s but want %s", got, want)
+	}
+}
+
+func TestClient_MiddlewareSeesOutcome(t *testing.T) {
+	mux, client, teardown := prepare()
+	defer teardown()
+
+	mux.HandleFunc("/locations", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`{"stations":[]}`))
+	})
+
+	var body []byte
+	var last error
+	client = mustWith(t, client, WithMiddleware(func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) ([]byte, error) {
+			req.Header.Set("Authorization", "Bearer secret")
+			body, last = next.Do(req)
+			return body, last
+		})
+	}))
+
+	if _, err := client.Location.Search(context.Background(), "Bern"); err != nil {
+		t.Fatalf("The middleware should authorize the request: %s", err)
+	}
+
+	if got, want := string(body), `{"stations":[]}`; got != want || last != nil {
+		t.Errorf("The middleware saw the body %s and error %v but want %s", got, last, want)
+	}
+
+	// An inner middleware removes the header again, the outer middleware sees the error
+	client = mustWith(t, client, WithMiddleware(func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) ([]byte, error) {
+			req.Header.Del("Authorization")
+			return next.Do(req)
+		})
+	}))
+
+	if _, err := client.Location.Search(context.Background(), "Bern"); !errors.Is(err, ErrBadRequest) {
+		t.Errorf("The request should fail with a bad request but got %v", err)
+	}
+
+	if !errors.Is(last, ErrBadRequest) {
+		t.Errorf("The middleware should see the error of the request but got %v", last)
+	}
+}
+
+func TestClient_MiddlewareShortCircuit(t *testing.T) {
+	_, client, teardown := prepare()
+	defer teardown()
+
+	fixture, _ := readFixture("location_search")
+	derived, err := client.With(WithMiddleware(func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) ([]byte, error) {
+			return []byte(fixture), nil
+		})
+	}))
+	if err != nil {
+		t.Fatalf("Failed to add a middleware: %s", err)
+	}
+
+	result, err := derived.Location.Search(context.Background(), "Bern")
+	if err != nil {
+		t.Fatalf("The middleware should answer the request: %s", err)
+	}
+
+	if len(result) == 0 {
+		t.Errorf("The response of the middleware should be parsed")
+	}
+
+	if len(client.cfg.middlewares) != 0 {
+		t.Errorf("The middleware of a derived client should not affect the parent")
+	}
+}
@@ -6,12 +6,12 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
-	"log"
 	"net/http"
 	"net/url"
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -27,21 +27,87 @@ const DefaultMaxRetry = 3
 // The default pause in seconds between multiple retry requests
 const DefaultRetryPause = 5
 
+// The default upper limit of a pause requested by the server with a Retry-After header
+const DefaultMaxRetryAfter = time.Minute
+
 // The client config holds all values configurable by a user. The type itself will be used internally.
 type clientConfig struct {
 	// The url of the remote api. Default is DefaultApiURL.
 	apiUrl *url.URL
 
+	// The http client used for all requests. Default is a new http.Client.
+	httpClient *http.Client
+
+	// The time limit of a single http request. Default is no limit.
+	timeout time.Duration
+
+	// The logger for debug and error logs. Default is a logger which discards all messages.
+	logger Logger
+
 	// The useragent which will be used for http requests.
 	userAgent string
 
+	// Static headers which will be added to all http requests.
+	headers http.Header
+
 	// The amount of retries if the http request to the api fails. Default is 3
 	maxRetry int
 
 	// The amount of seconds to sleep between retries
 	maxRetryPause int
+
+	// Decides if and when failed requests are repeated. Defaults to a ConstantBackoff based on maxRetry and maxRetryPause.
+	retryPolicy RetryPolicy
+
+	// The upper limit of a pause requested with a Retry-After header. Default is DefaultMaxRetryAfter.
+	maxRetryAfter time.Duration
+
+	// An optional client side rate limiter, which will be consulted before each attempt.
+	limiter *RateLimiter
+
+	// An optional cache for successful responses and the time to live per service.
+	cache    Cache
+	cacheTTL CacheTTL
+
+	// An optional store for the offline mode.
+	store Store
+
+	// Middlewares which wrap every request, the first one is the outermost.
+	middlewares []Middleware
+
+	// Records the requests of all services. Default is no recording.
+	metrics Metrics
+
+	// Creates spans for the service calls and the http attempts. Default is no tracing.
+	tracer Tracer
+
+	// Checks the version of the API during the creation of a client. Default is false.
+	compatibilityCheck bool
+
+	// The maximum size of a response body in bytes. Default is 0, which means no limit.
+	maxResponseSize int64
+
+	// Concurrent requests of the same url share one http request. Default is false.
+	coalesce bool
+
+	// An optional circuit breaker, which will be consulted before each attempt.
+	breaker *CircuitBreaker
+
+	// Multiple base urls with their health, if more than one was configured. The first one equals apiUrl.
+	endpoints *endpointPool
+
+	// Sends a second request, if an attempt takes longer than its delay. Default is no hedging.
+	hedger *Hedger
 }
 
+//go:generate go run gen_zoneinfo.go
+
+// The time zone of switzerland, loaded once by swissLocation
+var (
+	swissLoc     *time.Location
+	swissLocOnce sync.Once
+)
+
 // Transportation can be a Train, Bus, Tram, Ship or Cableway
 type Transportation string
 
@@ -59,77 +125,81 @@ type Client struct {
 	// The instance of a http client, which will be used for all HTTP Requests to the API
 	httpClient *http.Client
 
-	// Configuration, which can be changed during initialization
-	cfg   *clientConfig
-	debug *log.Logger
-	error *log.Logger
+	// Configuration, which can be changed during initialization. The config itself is never
+	// modified, changes replace it with an updated copy. Use config() to get a consistent snapshot.
+	mu  sync.RWMutex
+	cfg *clientConfig
+
+	// The requests which are currently in flight, used to coalesce identical requests
+	flights *flightGroup
 
 	// Services which can be used to query different parts of the API
 	Location     *LocationService
 	Connection   *ConnectionService
 	Stationboard *StationboardService
+	Info         *InfoService
 }
 
-// A custom date type to parse iso 8601 date strings
-type isoDate struct {
-	time.Time
-}
-
-// Creates a new opentrasport client, configured with default values
-// returns a opentransport client
+// Creates a new opentransport client, configured with default values.
+// Use New to configure the client with options.
+//
+// Returns a opentransport client
 func NewClient() *Client {
-	apiURL, _ := url.Parse(DefaultApiURL)
-
-	cfg := clientConfig{
-		apiUrl:    apiURL,
-		userAgent: DefaultUserAgent,
-		maxRetry:  DefaultMaxRetry,
-		maxRetryPause: DefaultRetryPause,
-	}
-
-	c, _ := newClientWithConfig(&http.Client{}, &cfg)
+	c, _ := New()
 	return c
 }
 
-// Creates a new opentransport client with a custom apiUrl
-// returns a opentransport client object
-func NewClientWithUrl(httpClient *http.Client, customURL string) (*Client, error) {
-	if len(customURL) == 0 {
-		return nil, fmt.Errorf("custom URL does not have to be empty")
+// Creates a new opentransport client, configured with default values and the provided options.
+//
+// Returns a opentransport client and an error if an option or the resulting configuration is invalid
+func New(opts ...Option) (*Client, error) {
+	cfg, err := newClientConfig(opts)
+	if err != nil {
+		return nil, fmt.Errorf("opentransport: invalid option: %w", err)
 	}
 
-	pApiURL, err := url.Parse(customURL)
+	c, err := newClientWithConfig(cfg)
 	if err != nil {
-		return nil, fmt.Errorf("could not parse api URL: %w", err)
+		return nil, err
 	}
 
-	if len(pApiURL.Scheme) == 0 {
-		return nil, fmt.Errorf("could not parse api URL: empty scheme")
-	}
+	c.checkCompatibility()
+	return c, nil
+}
 
-	if len(pApiURL.Host) == 0 {
-		return nil, fmt.Errorf("could not parse api URL: empty host")
+// Creates a new opentransport client with a custom apiUrl
+//
+// Deprecated: Use New with the options WithHTTPClient and WithBaseURL instead.
+//
+// Returns a opentransport client object
+func NewClientWithUrl(httpClient *http.Client, customURL string) (*Client, error) {
+	cfg, err := newClientConfig([]Option{WithHTTPClient(httpClient), WithBaseURL(customURL)})
+	if err != nil {
+		return nil, err
 	}
+	return newClientWithConfig(cfg)
+}
 
-	cfg := clientConfig{
-		apiUrl:    pApiURL,
-		userAgent: DefaultUserAgent,
-		maxRetry:  DefaultMaxRetry,
-		maxRetryPause: DefaultRetryPause,
+// Creates a new opentransport client based on a clientConfig type.
+// The config is validated once and must not be changed afterwards.
+//
+// Returns a configured opentransport client object
+func newClientWithConfig(cfg *clientConfig) (*Client, error) {
+	cfg, err := validClientConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("opentransport: invalid client config: %w", err)
 	}
-	return newClientWithConfig(httpClient, &cfg)
-}
 
-// Creates a new opentransport client based on a clientConfig type
-// returns a configured opentransport client object
-func newClientWithConfig(httpClient *http.Client, cfg *clientConfig) (*Client, error) {
+	httpClient := cfg.httpClient
 	if httpClient == nil {
 		httpClient = &http.Client{}
 	}
 
-	cfg, err := validClientConfig(cfg)
-	if err != nil {
-		return nil, fmt.Errorf("opentransport: invalid client config: %w", err)
+	// Use a copy of the http client, so that the timeout does not affect the one of the user
+	if cfg.timeout > 0 {
+		hc := *httpClient
+		hc.Timeout = cfg.timeout
+		httpClient = &hc
 	}
 
 	// Check if the apiUrl has a slash as suffix, otherwise add one
@@ -137,26 +207,64 @@ func newClientWithConfig(httpClient *http.Client, cfg *clientConfig) (*Client, e
 		cfg.apiUrl.Path += "/"
 	}
 
-	// Init default disabled loggers (Logs are written to devNull(0)
-	debugLogger := log.New(ioutil.Discard, "Debug:\t", log.Ldate|log.Ltime|log.Lshortfile)
-	errorLogger := log.New(ioutil.Discard, "Error:\t", log.Ldate|log.Ltime|log.Lshortfile)
-
 	// Create basic client
 	client := &Client{
 		cfg:        cfg,
 		httpClient: httpClient,
-		debug:      debugLogger,
-		error:      errorLogger,
+		flights:    newFlightGroup(),
 	}
 
+	cfg.observeBreakerState()
+
 	// Init all services
 	client.Location = newLocationService(client)
 	client.Connection = newConnectionService(client)
 	client.Stationboard = newStationboardService(client)
+	client.Info = newInfoService(client)
 
 	return client, nil
 }
 
+// Returns the current configuration. The returned config must not be modified.
+func (c *Client) config() *clientConfig {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.cfg
+}
+
+// Applies a change to a copy of the current configuration and replaces it afterwards.
+// Requests which are already running keep using the previous configuration.
+func (c *Client) update(change func(cfg *clientConfig)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cfg := c.cfg.clone()
+	change(cfg)
+	c.cfg = cfg
+}
+
+// Creates a copy of the configuration. Shared components like the cache or the rate limiter are not copied.
+func (cfg *clientConfig) clone() *clientConfig {
+	cp := *cfg
+	if cfg.apiUrl != nil {
+		u := *cfg.apiUrl
+		cp.apiUrl = &u
+	}
+	if cfg.headers != nil {
+		cp.headers = cfg.headers.Clone()
+	}
+	if cfg.middlewares != nil {
+		cp.middlewares = append([]Middleware(nil), cfg.middlewares...)
+	}
+	if cfg.cacheTTL != nil {
+		cp.cacheTTL = make(CacheTTL, len(cfg.cacheTTL))
+		for k, v := range cfg.cacheTTL {
+			cp.cacheTTL[k] = v
+		}
+	}
+	return &cp
+}
+
 // Validates a client config object and returns an error or a valid config.
 func validClientConfig(cfg *clientConfig) (*clientConfig, error) {
 	if cfg == nil {
@@ -183,6 +291,23 @@ func validClientConfig(cfg *clientConfig) (*clientConfig, error) {
 		return nil, err
 	}
 
+	if cfg.retryPolicy == nil {
+		cfg.retryPolicy = constantRetry(cfg.maxRetry, cfg.maxRetryPause)
+	}
+
+	// Logs are disabled by default
+	if cfg.logger == nil {
+		cfg.logger = nopLogger{}
+	}
+
+	if cfg.metrics == nil {
+		cfg.metrics = nopMetrics{}
+	}
+
+	if cfg.tracer == nil {
+		cfg.tracer = nopTracer{}
+	}
+
 	return cfg, nil
 }
 
@@ -199,7 +324,8 @@ func validRetry(attempts int, pause int) (bool, error) {
 	return true, nil
 }
 
-// Create new API Request based on a context and url path.
+// Create new API Request based on a context and url path. The request contains the User-Agent
+// and the static headers of the client as well as the per-call headers of the context.
 //
 // Returns a pointer to a http.Request. If an error occur, it will be returned.
 func (c *Client) NewRequest(ctx context.Context, path string) (*http.Request, error) {
@@ -207,9 +333,11 @@ func (c *Client) NewRequest(ctx context.Context, path string) (*http.Request, er
 		ctx = context.Background()
 	}
 
+	cfg := c.config()
+
 	// Prepare full URL
-	reqUrl := fmt.Sprintf("%s%s", c.cfg.apiUrl, path)
-	c.debug.Printf("Request url: %s", reqUrl)
+	reqUrl := fmt.Sprintf("%s%s", cfg.apiUrl, path)
+	cfg.logger.Log(LevelDebug, "create request", "url", reqUrl)
 
 	// Prepare Request
 	req, err := http.NewRequestWithContext(ctx, "GET", reqUrl, nil)
@@ -217,91 +345,274 @@ func (c *Client) NewRequest(ctx context.Context, path string) (*http.Request, er
 		return nil, fmt.Errorf("failed to create new request: %w", err)
 	}
 
+	cfg.setHeaders(req)
 	return req, nil
 }
 
-// The function passed as parameter will be retried until the max attempts is reached or no error returned.
-func (c *Client) retry(attempts int, sleep time.Duration, f func() ([]byte, error)) ([]byte, error) {
-	r, err := f()
+// Do the actual http request. Retries the http request according to the configured RetryPolicy,
+// which can be changed with the option WithRetryPolicy. By default, http errors, server errors and rate limit
+// errors are repeated. The retries stop as soon as the context of the request is canceled.
+// If a cache is configured, valid cached responses are returned without a http request.
+// In offline mode, the last stored response is returned if the API is not available.
+//
+// Returns a byte array of the body and an error if the request failed. When the server
+// respond with a status which does not match HTTP 200 OK, an *APIError will be returned.
+func (c *Client) Do(req *http.Request) ([]byte, error) {
+	res, err := c.do(req)
 	if err != nil {
-		if attempts--; attempts > 0 {
-			c.error.Printf("Retry attempt %d of %d: %s", c.cfg.maxRetry - attempts, c.cfg.maxRetry, err)
-			time.Sleep(sleep)
-			return c.retry(attempts, sleep, f)
-		}
+		return nil, err
 	}
-	return r, err
+	return res.body, nil
 }
 
-// Do the actual http request. Retries the http request, if an http 500 or a http error occur.
-// The max retries and the pause between can be configured with MaxRetry Method.
-//
-// Returns a byte array of the body and an error if the request failed. When the server
-// respond with a status which does not match HTTP 200 OK, an error will be returned
-func (c *Client) Do(req *http.Request) ([]byte, error) {
+// A raw response of the API with the information where it comes from
+type response struct {
+	body      []byte
+	fetchedAt time.Time // The time the response was received from the API
+	stale     bool      // True if the response was served by the offline store
+}
+
+// Does the request like Do, but returns the response with its metadata.
+func (c *Client) do(req *http.Request) (*response, error) {
 	if ok, err := validRequest(req); !ok {
 		return nil, fmt.Errorf("opentransport: invalid http request: %w", err)
 	}
 
-	pause :=  time.Duration(c.cfg.maxRetryPause) * time.Second
-	var r, err = c.retry(c.cfg.maxRetry, pause, func() ([]byte, error) {
-		r, err := c.httpClient.Do(req)
-		if err != nil {
-			return nil, fmt.Errorf("failed to proceed http request: %w", err)
+	// Use the same configuration during the whole request
+	return c.chain(c.config(), req)
+}
+
+// Performs the request with the cache, the retries and the offline mode of the config.
+func (c *Client) exec(cfg *clientConfig, req *http.Request) (*response, error) {
+	key, service := cfg.cacheKey(req)
+
+	if cache, _ := cfg.cacheFor(service); cache != nil {
+		entry, ok := cache.Get(key)
+		cfg.metrics.ObserveCache(service, ok)
+		if ok {
+			cfg.logger.Log(LevelDebug, "cache hit", "key", key, "storedAt", entry.StoredAt)
+			return &response{body: copyBytes(entry.Body), fetchedAt: entry.StoredAt}, nil
+		}
+		cfg.logger.Log(LevelDebug, "cache miss", "key", key)
+	}
+
+	if !cfg.coalesce {
+		return c.fetch(cfg, req, key, service)
+	}
+
+	// Concurrent requests of the same url share one http request
+	return c.flights.do(req.Context(), req.URL.String(), func(ctx context.Context) (*response, error) {
+		return c.fetch(cfg, req.WithContext(ctx), key, service)
+	})
+}
+
+// Sends the request and updates the cache and the offline store with a successful response.
+// If the request fails, the offline store is used as fallback.
+//
+// Returns the response or an error if the request failed
+func (c *Client) fetch(cfg *clientConfig, req *http.Request, key string, service Service) (*response, error) {
+	body, err := c.send(cfg, req, service)
+	if err != nil {
+		return c.offline(cfg, key, err)
+	}
+
+	now := time.Now()
+	if cache, ttl := cfg.cacheFor(service); cache != nil {
+		cache.Set(key, CacheEntry{Body: copyBytes(body), StoredAt: now, Expires: now.Add(ttl)})
+	}
+
+	if store := cfg.store; store != nil {
+		if err := store.Save(storeKey(key), CacheEntry{Body: copyBytes(body), StoredAt: now}); err != nil {
+			cfg.logger.Log(LevelError, "failed to store response", "key", key, "error", err)
+		}
+	}
+
+	return &response{body: body, fetchedAt: now}, nil
+}
+
+// Serves the last stored response of the key, if the offline mode is enabled and the error allows it.
+//
+// Returns a stale response or the original error
+func (c *Client) offline(cfg *clientConfig, key string, err error) (*response, error) {
+	// While the circuit is open, expired responses of the cache are served as well
+	if sc, ok := cfg.cache.(StaleCache); ok && errors.Is(err, ErrCircuitOpen) {
+		if entry, ok := sc.GetStale(key); ok {
+			cfg.logger.Log(LevelWarn, "serve cached response", "key", key, "storedAt", entry.StoredAt, "error", err)
+			return &response{body: copyBytes(entry.Body), fetchedAt: entry.StoredAt, stale: true}, nil
+		}
+	}
+
+	store := cfg.store
+	if store == nil || !offlineFallback(err) {
+		return nil, err
+	}
+
+	entry, ok := store.Load(storeKey(key))
+	if !ok {
+		return nil, err
+	}
+
+	cfg.logger.Log(LevelWarn, "serve stored response", "key", key, "storedAt", entry.StoredAt, "error", err)
+	return &response{body: entry.Body, fetchedAt: entry.StoredAt, stale: true}, nil
+}
+
+// Sends the http request and repeats it according to the retry policy.
+//
+// Returns a byte array of the body and an error if the request failed.
+func (c *Client) send(cfg *clientConfig, req *http.Request, service Service) ([]byte, error) {
+	body, _, err := c.roundTrip(cfg, req, service, false)
+	return body, err
+}
+
+// Sends the http request like send, but returns the unread body of a successful response.
+// The body is limited to the max response size and has to be closed by the caller.
+//
+// Returns the body and an error if the request failed.
+func (c *Client) stream(cfg *clientConfig, req *http.Request, service Service) (io.ReadCloser, error) {
+	_, body, err := c.roundTrip(cfg, req, service, true)
+	return body, err
+}
+
+// Sends the http request and repeats it according to the retry policy. If stream is true,
+// the body of a successful response is returned unread, otherwise it is read completely.
+//
+// Returns either the body as byte array or as reader and an error if the request failed.
+func (c *Client) roundTrip(cfg *clientConfig, req *http.Request, service Service, stream bool) ([]byte, io.ReadCloser, error) {
+	var reader io.ReadCloser
+	r, attempts, err := c.doWithRetry(req.Context(), cfg, func(attempt int) (out []byte, err error) {
+		if attempt > 1 {
+			cfg.metrics.ObserveRetry(service)
 		}
 
-		if r != nil {
-			defer r.Body.Close()
-			c.debug.Printf("Server responded with status %s", r.Status)
-
-			switch s := r.StatusCode; {
-			case s >= http.StatusInternalServerError:
-				return nil, fmt.Errorf("remote server responded with an error: %s", r.Status)
-			case s == http.StatusOK:
-				body, err := ioutil.ReadAll(r.Body)
-				if err != nil {
-					return nil, fmt.Errorf("failed to parse response (%d): %v ", err, r.StatusCode)
-				}
-				return body, nil
-			default:
-				return nil, nil
+		if cfg.breaker != nil {
+			t, rejected := cfg.breaker.allow()
+			c.observeTransition(cfg, t)
+			if rejected != nil {
+				return nil, rejected
 			}
+			defer func() {
+				c.observeTransition(cfg, cfg.breaker.record(req.Context(), err))
+			}()
 		}
-		return nil, errors.New("server response of the http request is empty")
+
+		if cfg.limiter != nil {
+			if err := cfg.limiter.Wait(req.Context()); err != nil {
+				return nil, err
+			}
+		}
+
+		body, rd, err := c.hedge(cfg, req, service, attempt, stream)
+		reader = rd
+		return body, err
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to perform the http request after %d retries", c.cfg.maxRetry)
+		var apiErr *APIError
+		if errors.As(err, &apiErr) {
+			apiErr.Attempts = attempts
+		}
+		return nil, nil, err
 	}
 
-	return r, nil
+	return r, reader, nil
+}
+
+// Performs a single http attempt. If stream is true, the body of a successful response is returned unread.
+//
+// Returns either the body as byte array or as reader and an error if the attempt failed.
+func (c *Client) attempt(cfg *clientConfig, req *http.Request, service Service, attempt int, stream bool) (out []byte, reader io.ReadCloser, err error) {
+	path := req.URL.Path
+
+	// Every attempt is traced as child span of the service call
+	ctx, span := cfg.tracer.Start(req.Context(), SpanHTTPAttempt,
+		Attr(AttrHTTPMethod, req.Method), Attr(AttrHTTPPath, path), Attr(AttrAttempt, attempt))
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	start := time.Now()
+	r, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		latency := time.Since(start)
+		// A canceled request, e.g. the loser of a hedge request, is neither a failed request nor a warning
+		level := LevelDebug
+		if ctx.Err() == nil {
+			level = LevelWarn
+			cfg.metrics.ObserveRequest(service, 0, latency, 0)
+		}
+		cfg.logger.Log(level, "http request failed", "method", req.Method, "path", path,
+			"latency", latency, "attempt", attempt, "error", err)
+		return nil, nil, &APIError{URL: req.URL.String(), Err: err}
+	}
+
+	// The size of a streamed body is unknown until it is read by the caller
+	if stream && r.StatusCode == http.StatusOK {
+		latency := time.Since(start)
+		span.SetAttributes(Attr(AttrHTTPStatus, r.StatusCode))
+		cfg.metrics.ObserveRequest(service, r.StatusCode, latency, -1)
+		cfg.logger.Log(LevelDebug, "http request", "method", req.Method, "path", path, "status", r.StatusCode,
+			"latency", latency, "attempt", attempt, "stream", true)
+		return nil, limitBody(r.Body, cfg.maxResponseSize), nil
+	}
+	defer r.Body.Close()
+
+	body, err := ioutil.ReadAll(limitBody(r.Body, cfg.maxResponseSize))
+	latency := time.Since(start)
+	span.SetAttributes(Attr(AttrHTTPStatus, r.StatusCode), Attr(AttrHTTPSize, len(body)))
+	if err == nil || ctx.Err() == nil {
+		cfg.metrics.ObserveRequest(service, r.StatusCode, latency, len(body))
+	}
+	cfg.logger.Log(LevelDebug, "http request", "method", req.Method, "path", path, "status", r.StatusCode,
+		"latency", latency, "attempt", attempt, "bytes", len(body))
+	if err != nil {
+		return nil, nil, &APIError{StatusCode: r.StatusCode, URL: req.URL.String(), Err: fmt.Errorf("failed to read response body: %w", err)}
+	}
+
+	if r.StatusCode != http.StatusOK {
+		return nil, nil, &APIError{
+			StatusCode: r.StatusCode,
+			URL:        req.URL.String(),
+			Body:       bodyExcerpt(body),
+			RetryAfter: parseRetryAfter(r.Header.Get("Retry-After"), time.Now()),
+		}
+	}
+
+	if len(body) == 0 {
+		return nil, nil, &APIError{StatusCode: r.StatusCode, URL: req.URL.String(), Err: ErrEmptyResponse}
+	}
+	return body, nil, nil
 }
 
 // Enable debug and error logs to a specified output.
 // When the output is nil, the debug logs will be written to os.Stdout
-// and the error logs to os.Stderr
+// and the error logs to os.Stderr. This replaces a logger configured with WithLogger.
 func (c *Client) EnableLogs(out io.Writer) {
+	logger := newStdLoggers(os.Stdout, os.Stderr)
 	if out != nil {
-		c.debug.SetOutput(out)
-		c.error.SetOutput(out)
-	} else {
-		c.debug.SetOutput(os.Stdout)
-		c.error.SetOutput(os.Stderr)
+		logger = newStdLoggers(out, out)
 	}
 
-	c.debug.Printf("Client is configured with target API: %s and useragent: '%s'", c.cfg.apiUrl, c.cfg.userAgent)
+	c.update(func(cfg *clientConfig) {
+		cfg.logger = logger
+	})
+
+	cfg := c.config()
+	cfg.logger.Log(LevelInfo, "client configured", "apiUrl", cfg.apiUrl.String(), "userAgent", cfg.userAgent)
 }
 
 // Sets a custom user agent. If the provided user agent is empty, the default one will be used.
 func (c *Client) UserAgent(userAgent string) {
-	if len(userAgent) > 0 {
-		c.cfg.userAgent = userAgent
-	} else {
-		c.cfg.userAgent = DefaultUserAgent
+	if len(userAgent) == 0 {
+		userAgent = DefaultUserAgent
 	}
+	c.update(func(cfg *clientConfig) {
+		cfg.userAgent = userAgent
+	})
 }
 
-// Sets the max attempts to retry and the pause between a http request.
+// Sets the max attempts to retry and the pause in seconds between a http request.
+//
+// Deprecated: Use the option WithRetryPolicy with a ConstantBackoff, ExponentialBackoff or JitteredBackoff instead.
 //
 // Returns an error if the provided value is invalid
 func (c *Client) MaxRetry(attempts int, pause int) error {
@@ -309,32 +620,33 @@ func (c *Client) MaxRetry(attempts int, pause int) error {
 	if _, err := validRetry(attempts, pause); err != nil {
 		return fmt.Errorf("failed to configure retry options: %w", err)
 	}
-	c.cfg.maxRetry = attempts
-	c.cfg.maxRetryPause = pause
+	c.update(func(cfg *clientConfig) {
+		cfg.maxRetry = attempts
+		cfg.maxRetryPause = pause
+		cfg.retryPolicy = constantRetry(attempts, pause)
+	})
 	return nil
 }
 
-// Parse date fields from format 2006-01-02T15:04:05Z0700 to time.Time. When
-// the field is nil, an empty time.Time will be unmarshal. Returns an error if a
-// invalid date format will be provided.
-func (d *isoDate) UnmarshalJSON(raw []byte) error {
-	i := string(raw)
-
-	// Check if the string contains an empty string or `null`
-	if strings.Contains(i, "null") || len(i) == 0 {
-		return nil
-	}
-
-	i = strings.Trim(i, `"`) // Remove doubled quotes
-
-	// Parse the date string to iso 8601
-	t, err := time.Parse("2006-01-02T15:04:05Z0700", i)
-	if err != nil {
-		return err
-	}
+// Creates the retry policy of the legacy retry configuration with a pause in seconds
+func constantRetry(attempts int, pause int) RetryPolicy {
+	return ConstantBackoff{Attempts: attempts, Pause: time.Duration(pause) * time.Second}
+}
 
-	d.Time = t
-	return nil
+// Returns the time zone of switzerland. The time zone database of the system is preferred, because it may
+// contain newer rules. Without it, the zoneinfo bundled with the library is used.
+func swissLocation() *time.Location {
+	swissLocOnce.Do(func() {
+		loc, err := time.LoadLocation("Europe/Zurich")
+		if err != nil {
+			// The bundled zoneinfo is verified by the tests, so this can not fail
+			if loc, err = time.LoadLocationFromTZData("Europe/Zurich", []byte(zurichTZData)); err != nil {
+				panic(fmt.Sprintf("opentransport: invalid bundled zoneinfo: %s", err))
+			}
+		}
+		swissLoc = loc
+	})
+	return swissLoc
 }
 
 // Generates a URL encoded string which can be appended to an url path.
@@ -400,6 +712,13 @@ func validRequest(req *http.Request) (bool, error) {
 	return true, nil
 }
 
+// Returns a copy of a byte slice, so that cached responses can not be modified by the caller
+func copyBytes(b []byte) []byte {
+	c := make([]byte, len(b))
+	copy(c, b)
+	return c
+}
+
 // Converts a boolean value to a numeric value
 //
 // Returns 1 or 0
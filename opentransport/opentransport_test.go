@@ -36,7 +36,7 @@ func prepare() (server *http.ServeMux, client *Client, terminate func()) {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		fixture, _ := readFixture("root")
-		_, _ = fmt.Fprintln(w, fixture)
+		_, _ = w.Write(fixture)
 	})
 
 	ts := httptest.NewServer(mux)
@@ -48,6 +48,16 @@ func prepare() (server *http.ServeMux, client *Client, terminate func()) {
 	return mux, c, ts.Close
 }
 
+// Derives a client with the options and stops the test if an option is invalid
+func mustWith(t *testing.T, c *Client, opts ...Option) *Client {
+	t.Helper()
+	d, err := c.With(opts...)
+	if err != nil {
+		t.Fatalf("Failed to apply the options: %s", err)
+	}
+	return d
+}
+
 func TestNewClient(t *testing.T) {
 	c := NewClient()
 
@@ -127,19 +137,15 @@ func TestClient_DefaultLoggerOutput(t *testing.T) {
 	c := NewClient()
 	c.EnableLogs(nil)
 
-	if c.debug == nil {
-		t.Errorf("No default io.Writer configured for the debug logger")
-		t.Skip("Skip default logging tests, because of missing output target")
-	}
-
-	if c.error == nil {
-		t.Errorf("No default io.Writer configured for the error logger")
+	logger, ok := c.cfg.logger.(*stdLogger)
+	if !ok {
+		t.Errorf("No default io.Writer configured for the loggers")
 		t.Skip("Skip default logging tests, because of missing output target")
 	}
 
 	// Check if the logger has a default output
-	debugOut := c.debug.Writer()
-	errorOut := c.error.Writer()
+	debugOut := logger.debug.Writer()
+	errorOut := logger.error.Writer()
 
 	// Check if the debug output is a type of os.File
 	if _, ok := debugOut.(*os.File); !ok {
@@ -166,7 +172,7 @@ func TestClient_CustomLoggerOutput(t *testing.T) {
 	c.EnableLogs(&out)
 
 	// Check if the logger did not wrote messages to the buffer
-	c.debug.Printf("Test Debug Message")
+	c.log(LevelDebug, "Test Debug Message")
 	if out.Len() == 0 {
 		t.Errorf("logs are enabled but defined debug log output will not be used for logging")
 	}
@@ -174,7 +180,7 @@ func TestClient_CustomLoggerOutput(t *testing.T) {
 	out.Reset() // clear buffer output
 
 	// Check if the logger did not wrote messages to the buffer
-	c.error.Printf("Test Error Message")
+	c.log(LevelError, "Test Error Message")
 	if out.Len() == 0 {
 		t.Errorf("logs are enabled but defined error log output will not be used for logging")
 	}
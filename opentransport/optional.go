@@ -0,0 +1,142 @@
+package opentransport
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+)
+
+// An integer of the API, which can be null, e.g. the delay of a stop without realtime data.
+// The zero value is null.
+type OptionalInt struct {
+	value int
+	valid bool
+}
+
+// A floating point number of the API, which can be null, e.g. the score of a location.
+// The zero value is null.
+type OptionalFloat struct {
+	value float64
+	valid bool
+}
+
+// Creates a non null integer, e.g. for a fake result in a test.
+//
+// Returns a valid OptionalInt
+func NewOptionalInt(v int) OptionalInt {
+	return OptionalInt{value: v, valid: true}
+}
+
+// Creates a non null floating point number, e.g. for a fake result in a test.
+//
+// Returns a valid OptionalFloat
+func NewOptionalFloat(v float64) OptionalFloat {
+	return OptionalFloat{value: v, valid: true}
+}
+
+// Reports whether the API returned a value. False means the value is unknown.
+func (o OptionalInt) Valid() bool {
+	return o.valid
+}
+
+// Returns the value or 0 if the value is unknown. This is the behaviour of the former int fields.
+func (o OptionalInt) Value() int {
+	return o.value
+}
+
+// Returns the value or the fallback if the value is unknown
+func (o OptionalInt) ValueOr(fallback int) int {
+	if !o.valid {
+		return fallback
+	}
+	return o.value
+}
+
+// Returns the value as string or null if the value is unknown
+func (o OptionalInt) String() string {
+	if !o.valid {
+		return "null"
+	}
+	return strconv.Itoa(o.value)
+}
+
+// Encodes the value as json number or null
+func (o OptionalInt) MarshalJSON() ([]byte, error) {
+	return []byte(o.String()), nil
+}
+
+// Decodes a json number or null. Numbers in quotes are accepted as well.
+func (o *OptionalInt) UnmarshalJSON(raw []byte) error {
+	s, null := optionalLiteral(raw)
+	if null {
+		*o = OptionalInt{}
+		return nil
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("invalid integer %s", raw)
+	}
+	*o = NewOptionalInt(v)
+	return nil
+}
+
+// Reports whether the API returned a value. False means the value is unknown.
+func (o OptionalFloat) Valid() bool {
+	return o.valid
+}
+
+// Returns the value or 0 if the value is unknown. This is the behaviour of the former number fields.
+func (o OptionalFloat) Value() float64 {
+	return o.value
+}
+
+// Returns the value or the fallback if the value is unknown
+func (o OptionalFloat) ValueOr(fallback float64) float64 {
+	if !o.valid {
+		return fallback
+	}
+	return o.value
+}
+
+// Returns the value as string or null if the value is unknown
+func (o OptionalFloat) String() string {
+	if !o.valid {
+		return "null"
+	}
+	return strconv.FormatFloat(o.value, 'g', -1, 64)
+}
+
+// Encodes the value as json number or null
+func (o OptionalFloat) MarshalJSON() ([]byte, error) {
+	return []byte(o.String()), nil
+}
+
+// Decodes a json number or null. Numbers in quotes are accepted as well.
+func (o *OptionalFloat) UnmarshalJSON(raw []byte) error {
+	s, null := optionalLiteral(raw)
+	if null {
+		*o = OptionalFloat{}
+		return nil
+	}
+
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return fmt.Errorf("invalid number %s", raw)
+	}
+	*o = NewOptionalFloat(v)
+	return nil
+}
+
+// Removes the quotes of a json literal.
+//
+// Returns the literal and true if it is null or an empty string
+func optionalLiteral(raw []byte) (string, bool) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return "", true
+	}
+
+	s := string(bytes.Trim(raw, `"`))
+	return s, len(s) == 0
+}
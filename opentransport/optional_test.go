@@ -0,0 +1,101 @@
+package opentransport
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOptionalInt_UnmarshalJSON(t *testing.T) {
+	var tests = []struct {
+		in    string
+		valid bool
+		want  int
+	}{
+		{`null`, false, 0},
+		{`""`, false, 0},
+		{`0`, true, 0},
+		{`4`, true, 4},
+		{`-1`, true, -1},
+		{`"12"`, true, 12},
+	}
+
+	for _, v := range tests {
+		var got OptionalInt
+		if err := json.Unmarshal([]byte(v.in), &got); err != nil {
+			t.Errorf("Failed to decode %s: %s", v.in, err)
+			continue
+		}
+		if got.Valid() != v.valid || got.Value() != v.want {
+			t.Errorf("Decoded %s to %s but want %d (valid %t)", v.in, got, v.want, v.valid)
+		}
+	}
+
+	var invalid OptionalInt
+	if err := json.Unmarshal([]byte(`"late"`), &invalid); err == nil {
+		t.Errorf("Decoding a string should return an error")
+	}
+}
+
+func TestOptionalFloat_UnmarshalJSON(t *testing.T) {
+	var tests = []struct {
+		in    string
+		valid bool
+		want  float64
+	}{
+		{`null`, false, 0},
+		{`0`, true, 0},
+		{`0.5`, true, 0.5},
+		{`"87.3"`, true, 87.3},
+	}
+
+	for _, v := range tests {
+		var got OptionalFloat
+		if err := json.Unmarshal([]byte(v.in), &got); err != nil {
+			t.Errorf("Failed to decode %s: %s", v.in, err)
+			continue
+		}
+		if got.Valid() != v.valid || got.Value() != v.want {
+			t.Errorf("Decoded %s to %s but want %g (valid %t)", v.in, got, v.want, v.valid)
+		}
+	}
+}
+
+func TestOptional_MarshalJSON(t *testing.T) {
+	stop := Stop{Delay: NewOptionalInt(0)}
+	stop.Station.Distance = NewOptionalInt(120)
+
+	raw, err := json.Marshal(stop)
+	if err != nil {
+		t.Fatalf("Failed to encode stop: %s", err)
+	}
+
+	var got Stop
+	if err := json.Unmarshal(raw, &got); err != nil {
+		t.Fatalf("Failed to decode stop: %s", err)
+	}
+
+	if !got.Delay.Valid() || got.Delay.Value() != 0 {
+		t.Errorf("A delay of 0 was not preserved: %s", got.Delay)
+	}
+	if got.Station.Score.Valid() {
+		t.Errorf("An unknown score was encoded as %s", got.Station.Score)
+	}
+	if got.Station.Distance.Value() != 120 {
+		t.Errorf("Got distance %s instead of 120", got.Station.Distance)
+	}
+}
+
+func TestOptional_ValueOr(t *testing.T) {
+	if got := (OptionalInt{}).ValueOr(-1); got != -1 {
+		t.Errorf("Got %d instead of the fallback for an unknown value", got)
+	}
+	if got := NewOptionalInt(3).ValueOr(-1); got != 3 {
+		t.Errorf("Got %d instead of the value", got)
+	}
+	if got := (OptionalFloat{}).ValueOr(1.5); got != 1.5 {
+		t.Errorf("Got %g instead of the fallback for an unknown value", got)
+	}
+	if got, want := (OptionalInt{}).String(), "null"; got != want {
+		t.Errorf("Got %s instead of %s", got, want)
+	}
+}
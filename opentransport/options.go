@@ -0,0 +1,222 @@
+package opentransport
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// An Option configures a client created by New or NewClient.
+type Option func(cfg *clientConfig) error
+
+// Sets the url of the remote API. Default is DefaultApiURL.
+func WithBaseURL(baseURL string) Option {
+	return func(cfg *clientConfig) error {
+		u, err := parseApiURL(baseURL)
+		if err != nil {
+			return err
+		}
+		cfg.apiUrl = u
+		cfg.endpoints = nil
+		return nil
+	}
+}
+
+// Sets the http client which will be used for all requests. Default is a new http.Client.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(cfg *clientConfig) error {
+		cfg.httpClient = httpClient
+		return nil
+	}
+}
+
+// Sets the user agent of all requests. If the user agent is empty, DefaultUserAgent will be used.
+func WithUserAgent(userAgent string) Option {
+	return func(cfg *clientConfig) error {
+		cfg.userAgent = userAgent
+		return nil
+	}
+}
+
+// Sets the policy which decides if and when failed requests are repeated.
+// If the policy is nil, failed requests will not be repeated.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(cfg *clientConfig) error {
+		if policy == nil {
+			policy = NoRetry
+		}
+		cfg.retryPolicy = policy
+		return nil
+	}
+}
+
+// Sets the upper limit of a pause requested by the server with a Retry-After header. A longer
+// pause of the retry policy is not shortened. A limit of 0 ignores the Retry-After header.
+func WithMaxRetryAfter(max time.Duration) Option {
+	return func(cfg *clientConfig) error {
+		if max < 0 {
+			return errors.New("the max retry after can not be negative")
+		}
+		cfg.maxRetryAfter = max
+		return nil
+	}
+}
+
+// Writes the debug and error logs to the logger. Use NewStdLogger to write to a logger of the
+// standard library. By default, no logs are written.
+func WithLogger(logger Logger) Option {
+	return func(cfg *clientConfig) error {
+		if logger == nil {
+			return errors.New("the logger can not be nil")
+		}
+		cfg.logger = logger
+		return nil
+	}
+}
+
+// Sets the time limit of a single http request, including reading the response body.
+// The http client passed by WithHTTPClient is not modified. A timeout of 0 means no timeout.
+func WithTimeout(timeout time.Duration) Option {
+	return func(cfg *clientConfig) error {
+		if timeout < 0 {
+			return errors.New("the timeout can not be negative")
+		}
+		cfg.timeout = timeout
+		return nil
+	}
+}
+
+// Enables caching of successful responses. The TTL defines how long responses of a service
+// are valid. If the TTL is nil, DefaultCacheTTL will be used. If the cache is nil, caching is disabled.
+func WithCache(cache Cache, ttl CacheTTL) Option {
+	return func(cfg *clientConfig) error {
+		if ttl == nil {
+			ttl = DefaultCacheTTL
+		}
+		cfg.cache = cache
+		cfg.cacheTTL = ttl
+		return nil
+	}
+}
+
+// Sets a client side rate limiter which is shared by all services. Every attempt, including
+// retries, consumes a part of the budget. If the limiter is nil, the rate limiting is disabled.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(cfg *clientConfig) error {
+		cfg.limiter = limiter
+		return nil
+	}
+}
+
+// Enables the offline mode. Every successful response is saved to the store. If the API is
+// unreachable or responds with a server error after all retries, the last stored response
+// of the query is served instead and the result is marked as stale. The date and time of
+// a query are ignored, so the last response is served even if the time has changed.
+// If the store is nil, the offline mode is disabled.
+func WithOfflineStore(store Store) Option {
+	return func(cfg *clientConfig) error {
+		cfg.store = store
+		return nil
+	}
+}
+
+// Creates the default configuration and applies all options to it.
+//
+// Returns the configuration or the error of the first invalid option
+func newClientConfig(opts []Option) (*clientConfig, error) {
+	apiURL, _ := url.Parse(DefaultApiURL)
+
+	cfg := &clientConfig{
+		apiUrl:        apiURL,
+		userAgent:     DefaultUserAgent,
+		maxRetry:      DefaultMaxRetry,
+		maxRetryPause: DefaultRetryPause,
+		maxRetryAfter: DefaultMaxRetryAfter,
+	}
+
+	if err := applyOptions(cfg, opts); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// Applies the options to the configuration. Nil options are ignored.
+//
+// Returns the error of the first invalid option
+func applyOptions(cfg *clientConfig, opts []Option) error {
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		if err := opt(cfg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Creates a derived client with the configuration of this client and the provided options.
+// The derived client shares the http client, the loggers, the cache, the rate limiter and
+// the offline store, unless they are replaced by an option. Changes of the derived client
+// do not affect this client.
+//
+//	// A client for latency sensitive calls, which never repeats a request
+//	fast, err := client.With(opentransport.WithRetryPolicy(opentransport.NoRetry))
+//
+// Returns the derived client and an error if an option is invalid
+func (c *Client) With(opts ...Option) (*Client, error) {
+	parent := c.config()
+
+	cfg := parent.clone()
+	if err := applyOptions(cfg, opts); err != nil {
+		return nil, fmt.Errorf("opentransport: invalid option: %w", err)
+	}
+
+	d, err := newClientWithConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Share the transport, if it was not replaced
+	if cfg.httpClient == parent.httpClient && cfg.timeout == parent.timeout {
+		d.httpClient = c.httpClient
+	}
+	return d, nil
+}
+
+// Creates a copy of the client with the same configuration. See With for the shared components.
+//
+// Returns a pointer to the copied client
+func (c *Client) Clone() *Client {
+	d, err := c.With()
+	if err != nil {
+		// The configuration of an existing client is always valid
+		panic(err)
+	}
+	return d
+}
+
+// Parses and validates the url of the remote api.
+//
+// Returns the parsed url or an error if the url is invalid
+func parseApiURL(apiURL string) (*url.URL, error) {
+	if len(apiURL) == 0 {
+		return nil, fmt.Errorf("custom URL does not have to be empty")
+	}
+
+	u, err := url.Parse(apiURL)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse api URL: %w", err)
+	}
+
+	if len(u.Scheme) == 0 {
+		return nil, fmt.Errorf("could not parse api URL: empty scheme")
+	}
+
+	if len(u.Host) == 0 {
+		return nil, fmt.Errorf("could not parse api URL: empty host")
+	}
+	return u, nil
+}
@@ -0,0 +1,218 @@
+package opentransport
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNew_Options(t *testing.T) {
+	httpClient := &http.Client{}
+	cache := NewLRUCache(10)
+	limiter := NewRateLimiter(RateLimit{PerSecond: 1})
+	policy := &ConstantBackoff{Attempts: 2, Pause: time.Second}
+	var out bytes.Buffer
+
+	c, err := New(
+		WithBaseURL("http://localhost:3001/v1"),
+		WithHTTPClient(httpClient),
+		WithUserAgent("Testing"),
+		WithRetryPolicy(policy),
+		WithLogger(NewStdLogger(log.New(&out, "", 0), LevelDebug)),
+		WithTimeout(10*time.Second),
+		WithCache(cache, nil),
+		WithRateLimiter(limiter),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create a client with options: %s", err)
+	}
+
+	if got, want := c.cfg.apiUrl.String(), "http://localhost:3001/v1/"; got != want {
+		t.Errorf("The client has the api url %s but want %s", got, want)
+	}
+
+	if got, want := c.cfg.userAgent, "Testing"; got != want {
+		t.Errorf("The client has the user agent %s but want %s", got, want)
+	}
+
+	if c.cfg.retryPolicy != policy {
+		t.Errorf("The client does not use the configured retry policy")
+	}
+
+	if c.cfg.cache != cache || c.cfg.limiter != limiter {
+		t.Errorf("The client does not use the configured cache and rate limiter")
+	}
+
+	if got, want := c.cfg.cacheTTL[ServiceLocation], DefaultCacheTTL[ServiceLocation]; got != want {
+		t.Errorf("The client has a location ttl of %s but want the default %s", got, want)
+	}
+
+	// The timeout is applied to a copy of the http client
+	if got, want := c.httpClient.Timeout, 10*time.Second; got != want {
+		t.Errorf("The http client has a timeout of %s but want %s", got, want)
+	}
+
+	if httpClient.Timeout != 0 {
+		t.Errorf("The http client of the user should not be modified")
+	}
+
+	c.log(LevelDebug, "Test Debug Message")
+	if out.Len() == 0 {
+		t.Errorf("The configured logger is not used")
+	}
+}
+
+func TestNew_InvalidOptions(t *testing.T) {
+	testValues := []struct {
+		opt  Option
+		want string
+	}{
+		{WithBaseURL(""), "custom URL does not have to be empty"},
+		{WithBaseURL("//localhost:3001/v1"), "empty scheme"},
+		{WithBaseURL("https:///v1"), "empty host"},
+		{WithTimeout(-time.Second), "timeout can not be negative"},
+		{WithLogger(nil), "logger can not be nil"},
+		{WithMaxRetryAfter(-time.Second), "max retry after can not be negative"},
+	}
+
+	for _, tv := range testValues {
+		_, err := New(tv.opt)
+		if err == nil {
+			t.Errorf("An invalid option should return an error: %s", tv.want)
+			continue
+		}
+
+		if got := err.Error(); !strings.Contains(got, tv.want) {
+			t.Errorf("The option returned the error '%s' but want '%s'", got, tv.want)
+		}
+	}
+}
+
+func TestNewClient_Defaults(t *testing.T) {
+	c := NewClient()
+
+	if got, want := c.cfg.apiUrl.String(), DefaultApiURL; got != want {
+		t.Errorf("The client has the api url %s but want %s", got, want)
+	}
+
+	if c.cfg.retryPolicy == nil {
+		t.Errorf("The client should have a default retry policy")
+	}
+}
+
+func TestClient_With(t *testing.T) {
+	srv, client, terminate := prepare()
+	defer terminate()
+
+	var calls int32
+	srv.HandleFunc("/locations", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	cache := NewLRUCache(10)
+	client = mustWith(t, client, WithCache(cache, nil))
+
+	fast, err := client.With(WithRetryPolicy(NoRetry), WithUserAgent("Fast"))
+	if err != nil {
+		t.Fatalf("Failed to derive a client: %s", err)
+	}
+
+	if _, err := fast.Location.Search(context.Background(), "Zürich"); err == nil {
+		t.Errorf("The request should fail")
+	}
+
+	if got, want := atomic.LoadInt32(&calls), int32(1); got != want {
+		t.Errorf("The derived client sent %d requests but should not retry", got)
+	}
+
+	// The derived client shares the transport and the cache
+	if fast.httpClient != client.httpClient {
+		t.Errorf("The derived client should share the http client")
+	}
+
+	if fast.config().cache != cache {
+		t.Errorf("The derived client should share the cache")
+	}
+
+	// The parent client is not affected
+	if got, want := client.config().userAgent, DefaultUserAgent; got != want {
+		t.Errorf("The parent client has the user agent %s but want %s", got, want)
+	}
+
+	if client.config().retryPolicy == NoRetry {
+		t.Errorf("The retry policy of the parent client should not be changed")
+	}
+
+	// A derived client with a timeout gets its own transport
+	slow, err := client.With(WithTimeout(time.Minute))
+	if err != nil {
+		t.Fatalf("Failed to derive a client: %s", err)
+	}
+
+	if slow.httpClient == client.httpClient || client.httpClient.Timeout != 0 {
+		t.Errorf("A derived client with a timeout should use a copy of the http client")
+	}
+
+	if _, err := client.With(WithBaseURL("")); err == nil {
+		t.Errorf("A derived client with an invalid option should not be created")
+	}
+}
+
+func TestClient_Clone(t *testing.T) {
+	client, err := New(WithUserAgent("Original"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %s", err)
+	}
+	clone := client.Clone()
+
+	clone.UserAgent("Clone")
+
+	if got, want := client.config().userAgent, "Original"; got != want {
+		t.Errorf("The original client has the user agent %s but want %s", got, want)
+	}
+
+	if got, want := clone.config().userAgent, "Clone"; got != want {
+		t.Errorf("The cloned client has the user agent %s but want %s", got, want)
+	}
+
+	if clone.Location == client.Location {
+		t.Errorf("The cloned client should have its own services")
+	}
+}
+
+func TestClient_ConcurrentReconfiguration(t *testing.T) {
+	srv, client, terminate := prepare()
+	defer terminate()
+
+	srv.HandleFunc("/locations", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"stations":[]}`)
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if _, err := client.Location.Search(context.Background(), "Zürich"); err != nil {
+				t.Errorf("Failed to search location: %s", err)
+			}
+		}()
+		go func(i int) {
+			defer wg.Done()
+			client.UserAgent(fmt.Sprintf("Agent %d", i))
+			_ = client.MaxRetry(2, 1)
+			if _, err := client.With(WithRetryPolicy(NoRetry), WithCache(NewLRUCache(10), nil)); err != nil {
+				t.Errorf("Failed to derive a client: %s", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
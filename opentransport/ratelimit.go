@@ -0,0 +1,238 @@
+package opentransport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// Returned by the rate limiter if no budget is left. Use errors.Is to check for it.
+var ErrQuotaExceeded = errors.New("opentransport: client side quota exceeded")
+
+// Configuration of a client side rate limiter. A value of 0 disables the corresponding limit.
+// The limits of the API are documented on https://timetable.search.ch/api/help
+type RateLimit struct {
+	PerSecond int  // The maximum amount of requests per second.
+	PerMinute int  // The maximum amount of requests per minute.
+	PerDay    int  // The daily quota. It is reset at midnight swiss time.
+	FailFast  bool // Return a QuotaError immediately instead of waiting for a free budget.
+}
+
+// The remaining budget of a rate limiter. Unlimited values are -1.
+type Budget struct {
+	Second     int       // Requests which can be sent immediately within the per second limit.
+	Minute     int       // Requests which can be sent immediately within the per minute limit.
+	Day        int       // Requests left in the daily quota.
+	DayResetAt time.Time // The time the daily quota will be reset.
+}
+
+// A QuotaError is returned if a request exceeds the budget of the rate limiter.
+type QuotaError struct {
+	Limit   string        // The exceeded limit: "second", "minute" or "day".
+	RetryIn time.Duration // The time until a budget is available again.
+}
+
+// Returns a description of the exceeded limit
+func (e *QuotaError) Error() string {
+	return fmt.Sprintf("opentransport: quota per %s exceeded, next request possible in %s", e.Limit, e.RetryIn)
+}
+
+// Reports whether the target is ErrQuotaExceeded
+func (e *QuotaError) Is(target error) bool {
+	return target == ErrQuotaExceeded
+}
+
+// A RateLimiter limits the requests of a client with a token bucket per second and per minute
+// and a daily quota counter. It is safe for concurrent use and can be shared between multiple clients.
+type RateLimiter struct {
+	mu       sync.Mutex
+	limit    RateLimit
+	second   *tokenBucket
+	minute   *tokenBucket
+	dayUsed  int
+	dayReset time.Time
+	now      func() time.Time
+}
+
+// A token bucket which refills continuously up to its capacity.
+type tokenBucket struct {
+	capacity float64
+	tokens   float64
+	interval time.Duration
+	last     time.Time
+}
+
+// Creates a new rate limiter. The buckets start full.
+//
+// Returns a pointer to a RateLimiter
+func NewRateLimiter(limit RateLimit) *RateLimiter {
+	l := &RateLimiter{limit: limit, now: time.Now}
+	now := l.now()
+	l.second = newTokenBucket(limit.PerSecond, time.Second, now)
+	l.minute = newTokenBucket(limit.PerMinute, time.Minute, now)
+	l.dayReset = nextMidnight(now)
+	return l
+}
+
+// Blocks until a request can be sent within the limits or the context is done. When the budget
+// can not be available before the deadline of the context, the daily quota is exhausted or
+// FailFast is set, a QuotaError is returned immediately.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		wait, limit, err := l.reserve()
+		if err != nil {
+			return err
+		}
+		if wait == 0 {
+			return nil
+		}
+
+		if deadline, ok := ctx.Deadline(); ok && l.now().Add(wait).After(deadline) {
+			return &QuotaError{Limit: limit, RetryIn: wait}
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Consumes a request from the budget without blocking.
+//
+// Returns true if the request is within the limits
+func (l *RateLimiter) Allow() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	_, _, ok := l.take(l.now())
+	return ok
+}
+
+// Returns the remaining budget of the limiter
+func (l *RateLimiter) Remaining() Budget {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.refill(now)
+
+	b := Budget{Second: -1, Minute: -1, Day: -1, DayResetAt: l.dayReset}
+	if l.second != nil {
+		b.Second = int(l.second.tokens)
+	}
+	if l.minute != nil {
+		b.Minute = int(l.minute.tokens)
+	}
+	if l.limit.PerDay > 0 {
+		b.Day = l.limit.PerDay - l.dayUsed
+	}
+	return b
+}
+
+// Tries to consume a request and calculates the pause until the next try.
+//
+// Returns zero if the request was consumed, otherwise the pause and the exceeded limit. Returns a
+// QuotaError if the limiter should not wait.
+func (l *RateLimiter) reserve() (time.Duration, string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	wait, limit, ok := l.take(l.now())
+	if ok {
+		return 0, "", nil
+	}
+
+	// An exhausted daily quota is not worth waiting for
+	if l.limit.FailFast || limit == "day" {
+		return 0, limit, &QuotaError{Limit: limit, RetryIn: wait}
+	}
+	return wait, limit, nil
+}
+
+// Consumes a token of all buckets and the daily quota, if all of them have a budget left.
+// Has to be called with a locked mutex.
+//
+// Returns the pause until the next token is available, the exceeded limit and if the request was consumed
+func (l *RateLimiter) take(now time.Time) (time.Duration, string, bool) {
+	l.refill(now)
+
+	if l.limit.PerDay > 0 && l.dayUsed >= l.limit.PerDay {
+		return l.dayReset.Sub(now), "day", false
+	}
+
+	if wait := l.second.wait(); wait > 0 {
+		return wait, "second", false
+	}
+
+	if wait := l.minute.wait(); wait > 0 {
+		return wait, "minute", false
+	}
+
+	l.second.take()
+	l.minute.take()
+	l.dayUsed++
+	return 0, "", true
+}
+
+// Refills the buckets and resets the daily quota. Has to be called with a locked mutex.
+func (l *RateLimiter) refill(now time.Time) {
+	l.second.refill(now)
+	l.minute.refill(now)
+
+	if !now.Before(l.dayReset) {
+		l.dayUsed = 0
+		l.dayReset = nextMidnight(now)
+	}
+}
+
+// Creates a full token bucket. Returns nil if the limit is disabled.
+func newTokenBucket(limit int, interval time.Duration, now time.Time) *tokenBucket {
+	if limit <= 0 {
+		return nil
+	}
+	return &tokenBucket{
+		capacity: float64(limit),
+		tokens:   float64(limit),
+		interval: interval,
+		last:     now,
+	}
+}
+
+// Adds the tokens which were generated since the last refill
+func (b *tokenBucket) refill(now time.Time) {
+	if b == nil || !now.After(b.last) {
+		return
+	}
+	rate := b.capacity / float64(b.interval)
+	b.tokens = math.Min(b.capacity, b.tokens+float64(now.Sub(b.last))*rate)
+	b.last = now
+}
+
+// Returns the time until a token is available or zero if a token is available now
+func (b *tokenBucket) wait() time.Duration {
+	if b == nil || b.tokens >= 1 {
+		return 0
+	}
+	rate := b.capacity / float64(b.interval)
+	return time.Duration(math.Ceil((1 - b.tokens) / rate))
+}
+
+// Removes a token from the bucket
+func (b *tokenBucket) take() {
+	if b != nil {
+		b.tokens--
+	}
+}
+
+// Returns the next midnight in swiss time
+func nextMidnight(now time.Time) time.Time {
+	t := now.In(swissLocation())
+	return time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,164 @@
+package opentransport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Creates a rate limiter with a manually controlled clock
+func newTestRateLimiter(limit RateLimit) (*RateLimiter, *time.Time) {
+	now := time.Date(2020, 5, 2, 20, 0, 0, 0, swissLocation())
+	l := NewRateLimiter(limit)
+	l.now = func() time.Time { return now }
+	l.second = newTokenBucket(limit.PerSecond, time.Second, now)
+	l.minute = newTokenBucket(limit.PerMinute, time.Minute, now)
+	l.dayReset = nextMidnight(now)
+	return l, &now
+}
+
+func TestRateLimiter_TokenBucket(t *testing.T) {
+	l, now := newTestRateLimiter(RateLimit{PerSecond: 2, PerMinute: 3})
+
+	for i := 0; i < 2; i++ {
+		if !l.Allow() {
+			t.Errorf("Request %d should be within the per second limit", i+1)
+		}
+	}
+
+	if l.Allow() {
+		t.Errorf("The third request within a second should exceed the limit")
+	}
+
+	*now = now.Add(time.Second)
+	if !l.Allow() {
+		t.Errorf("The bucket should be refilled after one second")
+	}
+
+	*now = now.Add(time.Second)
+	if l.Allow() {
+		t.Errorf("The per minute limit should be exceeded after three requests")
+	}
+}
+
+func TestRateLimiter_DailyQuota(t *testing.T) {
+	l, now := newTestRateLimiter(RateLimit{PerDay: 2})
+
+	_ = l.Allow()
+	_ = l.Allow()
+
+	err := l.Wait(context.Background())
+
+	var quotaErr *QuotaError
+	if !errors.As(err, &quotaErr) {
+		t.Fatalf("An exhausted daily quota should return a QuotaError but got %v", err)
+	}
+
+	if got, want := quotaErr.Limit, "day"; got != want {
+		t.Errorf("The quota error reports the limit '%s' but want '%s'", got, want)
+	}
+
+	if got, want := quotaErr.RetryIn, 4*time.Hour; got != want {
+		t.Errorf("The quota should be available again in %s but is %s", want, got)
+	}
+
+	// The quota is reset at midnight
+	*now = now.Add(4 * time.Hour)
+	if got, want := l.Remaining().Day, 2; got != want {
+		t.Errorf("The daily quota should be reset to %d but is %d", want, got)
+	}
+}
+
+func TestRateLimiter_FailFast(t *testing.T) {
+	l, _ := newTestRateLimiter(RateLimit{PerSecond: 1, FailFast: true})
+
+	if err := l.Wait(context.Background()); err != nil {
+		t.Errorf("The first request should be allowed: %s", err)
+	}
+
+	if err := l.Wait(context.Background()); !errors.Is(err, ErrQuotaExceeded) {
+		t.Errorf("A fail fast limiter should return ErrQuotaExceeded but got %v", err)
+	}
+}
+
+func TestRateLimiter_Deadline(t *testing.T) {
+	l := NewRateLimiter(RateLimit{PerMinute: 1})
+	_ = l.Allow()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	start := time.Now()
+	err := l.Wait(ctx)
+	if !errors.Is(err, ErrQuotaExceeded) {
+		t.Errorf("A limiter should fail if the budget is not available before the deadline but got %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("The limiter waited %s, but should fail immediately", elapsed)
+	}
+}
+
+func TestRateLimiter_Remaining(t *testing.T) {
+	l, _ := newTestRateLimiter(RateLimit{PerSecond: 5, PerDay: 100})
+	_ = l.Allow()
+
+	b := l.Remaining()
+	if b.Second != 4 || b.Minute != -1 || b.Day != 99 {
+		t.Errorf("The remaining budget is %+v but want 4 per second, unlimited per minute and 99 per day", b)
+	}
+}
+
+func TestClient_RateLimitShared(t *testing.T) {
+	srv, client, terminate := prepare()
+	defer terminate()
+
+	srv.HandleFunc("/locations", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"stations":[]}`)
+	})
+	srv.HandleFunc("/stationboard", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"stationboard":[]}`)
+	})
+
+	limiter := NewRateLimiter(RateLimit{PerSecond: 100, PerDay: 10, FailFast: true})
+	client = mustWith(t, client, WithRateLimiter(limiter))
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			var err error
+			if i%2 == 0 {
+				_, err = client.Location.Search(context.Background(), "Zürich")
+			} else {
+				_, err = client.Stationboard.Search(context.Background(), "Zürich")
+			}
+			errs <- err
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	failed := 0
+	for err := range errs {
+		if errors.Is(err, ErrQuotaExceeded) {
+			failed++
+		} else if err != nil {
+			t.Errorf("Unexpected error: %s", err)
+		}
+	}
+
+	if got, want := failed, 10; got != want {
+		t.Errorf("%d requests exceeded the quota but want %d", got, want)
+	}
+
+	if got := limiter.Remaining().Day; got != 0 {
+		t.Errorf("The daily quota should be exhausted but %d requests are left", got)
+	}
+}
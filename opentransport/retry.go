@@ -0,0 +1,224 @@
+package opentransport
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A RetryPolicy decides if a failed request will be repeated and how long the client
+// pauses before the next attempt. Use one of the built-in policies ConstantBackoff,
+// ExponentialBackoff, JitteredBackoff or NoRetry, or provide your own implementation.
+type RetryPolicy interface {
+	// Next is called after a failed attempt. The attempt starts at 1 for the first request.
+	// Returns the pause before the next attempt and false, if the request should not be repeated.
+	Next(attempt int, err error) (time.Duration, bool)
+}
+
+// The upper limit of a pause of ExponentialBackoff and JitteredBackoff without a Max.
+const DefaultMaxBackoff = 5 * time.Minute
+
+// Decides if an error of a failed attempt is retryable.
+type RetryableFunc func(err error) bool
+
+// Repeats a failed request with the same pause between all attempts.
+type ConstantBackoff struct {
+	Attempts  int           // The maximum amount of attempts, including the first request.
+	Pause     time.Duration // The pause between two attempts.
+	Retryable RetryableFunc // Decides which errors are retried. Defaults to DefaultRetryable.
+}
+
+// Repeats a failed request with a pause which grows exponentially with each attempt.
+type ExponentialBackoff struct {
+	Attempts   int           // The maximum amount of attempts, including the first request.
+	Initial    time.Duration // The pause after the first failed attempt.
+	Max        time.Duration // The upper limit of a pause. Defaults to DefaultMaxBackoff.
+	Multiplier float64       // The factor the pause grows with each attempt. Defaults to 2.
+	Retryable  RetryableFunc // Decides which errors are retried. Defaults to DefaultRetryable.
+}
+
+// Repeats a failed request like ExponentialBackoff, but chooses a random pause between
+// zero and the exponential pause. This spreads the retries of many clients over time.
+type JitteredBackoff ExponentialBackoff
+
+// Never repeats a failed request.
+var NoRetry RetryPolicy = noRetry{}
+
+// The policy behind NoRetry
+type noRetry struct{}
+
+// The default retry classification. Network errors including timeouts, server errors (HTTP 5xx) and
+// rate limit errors (HTTP 429) are retried. Client errors, canceled requests, exceeded client side
+// quotas and open circuits are not. Requests of a caller whose context is done are never retried,
+// regardless of the policy.
+//
+// Returns true if the error is retryable
+func DefaultRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, ErrQuotaExceeded) || errors.Is(err, ErrCircuitOpen) {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Temporary()
+	}
+	return true
+}
+
+// Returns the constant pause as long as the max attempts are not reached.
+func (b ConstantBackoff) Next(attempt int, err error) (time.Duration, bool) {
+	if attempt >= b.Attempts || !retryable(b.Retryable, err) {
+		return 0, false
+	}
+	return b.Pause, true
+}
+
+// Never allows a retry.
+func (noRetry) Next(attempt int, err error) (time.Duration, bool) {
+	return 0, false
+}
+
+// Returns an exponential growing pause as long as the max attempts are not reached.
+// The pause is limited to the max, also if the exponential pause overflows.
+func (b ExponentialBackoff) Next(attempt int, err error) (time.Duration, bool) {
+	if attempt >= b.Attempts || !retryable(b.Retryable, err) {
+		return 0, false
+	}
+
+	multiplier := b.Multiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+
+	max := b.Max
+	if max <= 0 {
+		max = DefaultMaxBackoff
+	}
+
+	// Compare before the conversion, a float larger than the max duration does not fit into a duration
+	pause := float64(b.Initial) * math.Pow(multiplier, float64(attempt-1))
+	if math.IsNaN(pause) || pause < 0 || pause >= float64(max) {
+		return max, true
+	}
+	return time.Duration(pause), true
+}
+
+// Returns a random pause between zero and the exponential pause as long as the max attempts are not reached.
+func (b JitteredBackoff) Next(attempt int, err error) (time.Duration, bool) {
+	pause, ok := ExponentialBackoff(b).Next(attempt, err)
+	if !ok || pause <= 0 {
+		return pause, ok
+	}
+	return time.Duration(rand.Int63n(int64(pause) + 1)), true
+}
+
+// The function passed as parameter will be repeated as long as the retry policy of the config allows it
+// and the context is not done. The function receives the number of the attempt, starting at 1.
+// A Retry-After header of the server extends the pause of the policy up to the max retry after.
+// The pause is interrupted as soon as the context is canceled.
+//
+// Returns the result of the last call and the amount of attempts made.
+func (c *Client) doWithRetry(ctx context.Context, cfg *clientConfig, f func(attempt int) ([]byte, error)) ([]byte, int, error) {
+	for attempt := 1; ; attempt++ {
+		r, err := f(attempt)
+		if err == nil {
+			return r, attempt, nil
+		}
+
+		// The caller gave up, a timeout of a single attempt is retried
+		if ctx.Err() != nil {
+			return nil, attempt, err
+		}
+
+		pause, ok := cfg.retryPolicy.Next(attempt, err)
+		if !ok {
+			return nil, attempt, err
+		}
+
+		pause = retryPause(cfg, pause, err)
+
+		cfg.logger.Log(LevelWarn, "retry request", "attempt", attempt, "pause", pause, "error", err)
+
+		timer := time.NewTimer(pause)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, attempt, ctxError(ctx, err)
+		case <-timer.C:
+		}
+	}
+}
+
+// Wraps the error of a canceled context into the error of the last attempt
+func ctxError(ctx context.Context, last error) error {
+	var apiErr *APIError
+	if errors.As(last, &apiErr) {
+		return &APIError{StatusCode: apiErr.StatusCode, URL: apiErr.URL, Body: apiErr.Body, Err: ctx.Err()}
+	}
+	return ctx.Err()
+}
+
+// Uses the retryable func or falls back to DefaultRetryable
+func retryable(f RetryableFunc, err error) bool {
+	if f == nil {
+		return DefaultRetryable(err)
+	}
+	return f(err)
+}
+
+// Extends the pause of the policy to the pause requested by the server, which is limited to the
+// max retry after of the config.
+//
+// Returns the longer of both pauses
+func retryPause(cfg *clientConfig, pause time.Duration, err error) time.Duration {
+	ra := retryAfter(err)
+	if ra > cfg.maxRetryAfter {
+		ra = cfg.maxRetryAfter
+	}
+	if ra > pause {
+		return ra
+	}
+	return pause
+}
+
+// Returns the duration the server requested to wait before the next request, or zero if unknown
+func retryAfter(err error) time.Duration {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.RetryAfter
+	}
+	return 0
+}
+
+// Parses the Retry-After header, which can contain seconds or a http date.
+//
+// Returns the duration to wait or zero if the header is missing or invalid
+func parseRetryAfter(header string, now time.Time) time.Duration {
+	header = strings.TrimSpace(header)
+	if len(header) == 0 {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(header); err == nil {
+		if d := date.Sub(now); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
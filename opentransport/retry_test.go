@@ -0,0 +1,283 @@
+package opentransport
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestConstantBackoff_Next(t *testing.T) {
+	b := ConstantBackoff{Attempts: 3, Pause: time.Second}
+	serverErr := &APIError{StatusCode: http.StatusInternalServerError}
+
+	testValues := []struct {
+		attempt int
+		err     error
+		pause   time.Duration
+		ok      bool
+	}{
+		{1, serverErr, time.Second, true},
+		{2, serverErr, time.Second, true},
+		{3, serverErr, 0, false},
+		{1, &APIError{StatusCode: http.StatusNotFound}, 0, false},
+		{1, context.Canceled, 0, false},
+	}
+
+	for _, tv := range testValues {
+		pause, ok := b.Next(tv.attempt, tv.err)
+		if pause != tv.pause || ok != tv.ok {
+			t.Errorf("Attempt %d with %v returned (%s, %t) but want (%s, %t)", tv.attempt, tv.err, pause, ok, tv.pause, tv.ok)
+		}
+	}
+}
+
+func TestExponentialBackoff_Next(t *testing.T) {
+	b := ExponentialBackoff{Attempts: 10, Initial: 100 * time.Millisecond, Max: time.Second}
+	serverErr := &APIError{StatusCode: http.StatusBadGateway}
+
+	want := []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		time.Second,
+	}
+
+	for i, w := range want {
+		if got, _ := b.Next(i+1, serverErr); got != w {
+			t.Errorf("Attempt %d returned a pause of %s but want %s", i+1, got, w)
+		}
+	}
+
+	// A jittered backoff never exceeds the exponential pause
+	j := JitteredBackoff(b)
+	for i := 1; i < 10; i++ {
+		max, _ := b.Next(i, serverErr)
+		if got, ok := j.Next(i, serverErr); !ok || got > max || got < 0 {
+			t.Errorf("Jittered attempt %d returned a pause of %s which is not between 0 and %s", i, got, max)
+		}
+	}
+}
+
+func TestExponentialBackoff_Overflow(t *testing.T) {
+	serverErr := &APIError{StatusCode: http.StatusBadGateway}
+
+	testValues := []struct {
+		b    ExponentialBackoff
+		want time.Duration
+	}{
+		{ExponentialBackoff{Attempts: 200, Initial: time.Second}, DefaultMaxBackoff},
+		{ExponentialBackoff{Attempts: 200, Initial: time.Second, Max: 10 * time.Second}, 10 * time.Second},
+		{ExponentialBackoff{Attempts: 200, Initial: time.Second, Multiplier: math.MaxFloat64}, DefaultMaxBackoff},
+	}
+
+	for _, tv := range testValues {
+		for _, attempt := range []int{30, 40, 100} {
+			if got, _ := tv.b.Next(attempt, serverErr); got != tv.want {
+				t.Errorf("Attempt %d returned a pause of %s but want %s", attempt, got, tv.want)
+			}
+
+			if got, _ := JitteredBackoff(tv.b).Next(attempt, serverErr); got < 0 || got > tv.want {
+				t.Errorf("Jittered attempt %d returned a pause of %s which is not between 0 and %s", attempt, got, tv.want)
+			}
+		}
+	}
+}
+
+func TestRetryPause(t *testing.T) {
+	cfg := &clientConfig{maxRetryAfter: time.Minute}
+
+	testValues := []struct {
+		pause      time.Duration
+		retryAfter time.Duration
+		want       time.Duration
+	}{
+		{time.Millisecond, 0, time.Millisecond},
+		{time.Millisecond, 8 * time.Second, 8 * time.Second},
+		{10 * time.Second, time.Second, 10 * time.Second},
+		{time.Millisecond, time.Hour, time.Minute},
+		{2 * time.Minute, time.Hour, 2 * time.Minute},
+	}
+
+	for _, tv := range testValues {
+		err := &APIError{StatusCode: http.StatusTooManyRequests, RetryAfter: tv.retryAfter}
+		if got := retryPause(cfg, tv.pause, err); got != tv.want {
+			t.Errorf("The pause %s with a Retry-After of %s returned %s but want %s", tv.pause, tv.retryAfter, got, tv.want)
+		}
+	}
+
+	// A limit of 0 ignores the header
+	cfg.maxRetryAfter = 0
+	if got := retryPause(cfg, time.Millisecond, &APIError{RetryAfter: time.Second}); got != time.Millisecond {
+		t.Errorf("The Retry-After header should be ignored without a limit but the pause is %s", got)
+	}
+}
+
+func TestBackoff_CustomRetryable(t *testing.T) {
+	onlyServerErrors := func(err error) bool {
+		return errors.Is(err, ErrServerError)
+	}
+	b := ConstantBackoff{Attempts: 3, Retryable: onlyServerErrors}
+
+	if _, ok := b.Next(1, &APIError{StatusCode: http.StatusTooManyRequests}); ok {
+		t.Errorf("A rate limit error should not be retried by the custom classification")
+	}
+
+	if _, ok := b.Next(1, &APIError{StatusCode: http.StatusServiceUnavailable}); !ok {
+		t.Errorf("A server error should be retried by the custom classification")
+	}
+}
+
+func TestClient_RetryContextCanceled(t *testing.T) {
+	srv, client, terminate := prepare()
+	defer terminate()
+
+	srv.HandleFunc("/locations", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	client = mustWith(t, client, WithRetryPolicy(ConstantBackoff{Attempts: 5, Pause: time.Minute}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.Location.Search(ctx, "Zürich")
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("A canceled context should stop the retries but got %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("The client waited %s after the context was canceled", elapsed)
+	}
+}
+
+func TestClient_RetryAfter(t *testing.T) {
+	srv, client, terminate := prepare()
+	defer terminate()
+
+	var calls int32
+	srv.HandleFunc("/locations", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"stations":[]}`))
+	})
+
+	client = mustWith(t, client, WithRetryPolicy(ConstantBackoff{Attempts: 2, Pause: time.Millisecond}))
+
+	start := time.Now()
+	if _, err := client.Location.Search(context.Background(), "Zürich"); err != nil {
+		t.Errorf("The request should succeed after the retry: %s", err)
+	}
+
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("The client did not honor the Retry-After header and retried after %s", elapsed)
+	}
+}
+
+func TestClient_NoRetry(t *testing.T) {
+	srv, client, terminate := prepare()
+	defer terminate()
+
+	var calls int32
+	srv.HandleFunc("/locations", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	client = mustWith(t, client, WithRetryPolicy(nil))
+
+	if _, err := client.Location.Search(context.Background(), "Zürich"); err == nil {
+		t.Errorf("The request should fail")
+	}
+
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("The request was sent %d times but retries are disabled", got)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2020, 5, 2, 20, 0, 0, 0, time.UTC)
+
+	testValues := []struct {
+		in   string
+		want time.Duration
+	}{
+		{"", 0},
+		{"120", 2 * time.Minute},
+		{"-1", 0},
+		{"Sat, 02 May 2020 20:00:30 GMT", 30 * time.Second},
+		{"Sat, 02 May 2020 19:00:00 GMT", 0},
+		{"soon", 0},
+	}
+
+	for _, tv := range testValues {
+		if got := parseRetryAfter(tv.in, now); got != tv.want {
+			t.Errorf("Retry-After '%s' parsed to %s but want %s", tv.in, got, tv.want)
+		}
+	}
+}
+
+func TestClient_RetryTimeout(t *testing.T) {
+	srv, client, terminate := prepare()
+	defer terminate()
+
+	var calls int32
+	srv.HandleFunc("/locations", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			// Hang until the attempt times out
+			<-r.Context().Done()
+			return
+		}
+		_, _ = w.Write([]byte(`{"stations":[]}`))
+	})
+
+	c, err := client.With(WithTimeout(50*time.Millisecond), WithRetryPolicy(ConstantBackoff{Attempts: 3, Pause: time.Millisecond}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.Location.Search(context.Background(), "Zürich"); err != nil {
+		t.Errorf("The request should succeed after the timed out attempts: %s", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 3 {
+		t.Errorf("The request was sent %d times instead of 3", got)
+	}
+}
+
+func TestClient_RetryAfterLimit(t *testing.T) {
+	srv, client, terminate := prepare()
+	defer terminate()
+
+	var calls int32
+	srv.HandleFunc("/locations", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"stations":[]}`))
+	})
+
+	client = mustWith(t, client,
+		WithRetryPolicy(ConstantBackoff{Attempts: 2, Pause: time.Millisecond}),
+		WithMaxRetryAfter(100*time.Millisecond),
+	)
+
+	start := time.Now()
+	if _, err := client.Location.Search(context.Background(), "Zürich"); err != nil {
+		t.Errorf("The request should succeed after the retry: %s", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 3*time.Second {
+		t.Errorf("The Retry-After header was not limited to the max retry after, the client waited %s", elapsed)
+	}
+}
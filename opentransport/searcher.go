@@ -0,0 +1,41 @@
+package opentransport
+
+import (
+	"context"
+	"time"
+)
+
+// A LocationSearcher searches for locations. It is implemented by LocationService and can be replaced
+// by a fake in unit tests, e.g. of the opentransportfake package.
+type LocationSearcher interface {
+	Search(ctx context.Context, name string) ([]Location, error)
+	SearchWithType(ctx context.Context, name string, locationType LocationType) ([]Location, error)
+	SearchWithOpts(ctx context.Context, name string, opts LocOpts) ([]Location, error)
+	SearchWithCoordinates(ctx context.Context, lat float64, long float64) ([]Location, error)
+}
+
+// A ConnectionSearcher searches for connections between two locations. It is implemented by ConnectionService
+// and can be replaced by a fake in unit tests, e.g. of the opentransportfake package.
+type ConnectionSearcher interface {
+	Search(ctx context.Context, from string, to string, date time.Time) (*ConnectionResult, error)
+	SearchVia(ctx context.Context, from string, to string, date time.Time, via []string) (*ConnectionResult, error)
+	SearchWithOpts(ctx context.Context, from string, to string, date time.Time, opts *ConnOpts) (*ConnectionResult, error)
+	Stream(ctx context.Context, from string, to string, date time.Time, opts *ConnOpts) (*ConnectionIterator, error)
+}
+
+// A StationboardSearcher searches for the departures or arrivals of a location. It is implemented by
+// StationboardService and can be replaced by a fake in unit tests, e.g. of the opentransportfake package.
+type StationboardSearcher interface {
+	Search(ctx context.Context, name string) (*StationboardResult, error)
+	SearchWithDate(ctx context.Context, name string, date time.Time) (*StationboardResult, error)
+	SearchWithType(ctx context.Context, name string, date time.Time, transportations []Transportation) (*StationboardResult, error)
+	SearchWithOpts(ctx context.Context, name string, opts StbOpts) (*StationboardResult, error)
+	Stream(ctx context.Context, name string, opts StbOpts) (*StationboardIterator, error)
+}
+
+// The services implement the searcher interfaces
+var (
+	_ LocationSearcher     = (*LocationService)(nil)
+	_ ConnectionSearcher   = (*ConnectionService)(nil)
+	_ StationboardSearcher = (*StationboardService)(nil)
+)
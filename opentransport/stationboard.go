@@ -30,6 +30,9 @@ type StbOpts struct {
 	// If multiple connections leave at the same time it'll return any connections
 	// that leave at the same time as the last connection within the limit.
 	Limit          int
+
+	// Limits the attributes of the response, e.g. StationboardStopDeparture. Default are all attributes.
+	Fields []Field
 }
 
 type StationboardResult struct {
@@ -38,6 +41,12 @@ type StationboardResult struct {
 
 	// A list of transportation with the stop of the line leaving or arriving from/to that station.
 	Journeys []StationBoardJourney `json:"stationboard"`
+
+	// True if the result was served by the offline store, because the API was not available.
+	Stale bool `json:"-"`
+
+	// The time the result was received from the API.
+	FetchedAt time.Time `json:"-"`
 }
 
 // The actual transportation of a connection, e.g. a bus or a train with the stop of the line leaving or arriving from/to that station.
@@ -111,10 +120,52 @@ func (s *StationboardService) SearchWithOpts(ctx context.Context, name string, o
 		return nil, err
 	}
 
-	return s.query(ctx, path)
+	return s.query(ctx, path, s.spanAttributes(name, opts)...)
 }
 
-func (s *StationboardService) query(ctx context.Context, path string) (*StationboardResult, error) {
+// Search for the next connections leaving or arriving from a specific location like SearchWithOpts, but decodes
+// the journeys lazily while iterating over them. The size of the response can be limited with WithMaxResponseSize.
+// Streamed responses are not cached, not saved to the offline store and do not pass the middlewares.
+// The iterator has to be closed after use.
+//
+// Returns a StationboardIterator and an error if the request failed.
+func (s *StationboardService) Stream(ctx context.Context, name string, opts StbOpts) (it *StationboardIterator, err error) {
+	path, err := s.buildUrlPath(name, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, span := s.client.startSpan(ctx, SpanStationboardSearch, append(s.spanAttributes(name, opts), Attr(AttrStream, true))...)
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	body, err := s.client.openStream(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return NewStationboardIterator(body), nil
+}
+
+// Returns the span attributes of a stationboard search
+func (s *StationboardService) spanAttributes(name string, opts StbOpts) []Attribute {
+	return []Attribute{
+		Attr(AttrStation, name),
+		Attr(AttrLimit, opts.Limit),
+		Attr(AttrTransportations, convSlice(opts.Transportations)),
+	}
+}
+
+// Runs a stationboard query within a span with the attributes and returns a StationboardResult struct
+func (s *StationboardService) query(ctx context.Context, path string, attrs ...Attribute) (result *StationboardResult, err error) {
+	ctx, span := s.client.startSpan(ctx, SpanStationboardSearch, attrs...)
+	defer func() {
+		if result != nil {
+			span.SetAttributes(Attr(AttrResultCount, len(result.Journeys)), Attr(AttrStale, result.Stale))
+		}
+		endSpan(span, err)
+	}()
+
 	if len(path) == 0 {
 		return nil, errors.New("the request path can not be empty")
 	}
@@ -124,12 +175,19 @@ func (s *StationboardService) query(ctx context.Context, path string) (*Stationb
 		return nil, err
 	}
 
-	res, err := s.client.Do(req)
+	res, err := s.client.do(req)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.parseResponse(res)
+	stbResult, err := s.parseResponse(res.body)
+	if err != nil {
+		return nil, err
+	}
+
+	stbResult.Stale = res.stale
+	stbResult.FetchedAt = res.fetchedAt
+	return stbResult, nil
 }
 
 // Generates a formatted and url encoded path out of the provided parameters.
@@ -162,18 +220,25 @@ func (s *StationboardService) buildUrlPath(name string, opts StbOpts) (string, e
 		return "", fmt.Errorf("failed to build url path: %w", err)
 	}
 
-	path := fmt.Sprintf("stationboard?%s=%s&limit=%d&type=%s&datetime=%s%s",
+	fields, err := convFields(opts.Fields, StationboardResult{})
+	if err != nil {
+		return "", err
+	}
+
+	path := fmt.Sprintf("stationboard?%s=%s&limit=%d&type=%s&datetime=%s%s%s",
 		stationAttr,
 		url.PathEscape(name),
 		opts.Limit,
 		url.PathEscape(directionType),
 		url.PathEscape(date),
-		transportations)
+		transportations,
+		fields)
 
 	return path, nil
 }
 
-// Parse a time.Time Date / Time in a date string with a format, accepted by the API
+// Parse a time.Time Date / Time in a date string with a format, accepted by the API.
+// The time is converted to the time zone of switzerland first.
 //
 // Returns a connection date and time of type time.Time and an error object
 func (s *StationboardService) formatDate(date time.Time) (string, error) {
@@ -181,7 +246,7 @@ func (s *StationboardService) formatDate(date time.Time) (string, error) {
 	if date.IsZero() {
 		return "", fmt.Errorf("provided date is zero: please provide a valid time.Time as date")
 	}
-	return date.Format("2006-01-02 15:04"), nil
+	return date.In(swissLocation()).Format("2006-01-02 15:04"), nil
 }
 
 // Parse a json response to a connection response type
@@ -198,6 +263,6 @@ func (s *StationboardService) parseResponse(raw []byte) (*StationboardResult, er
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
 
-	s.client.debug.Printf("Parsed stationboard response with %d bytes to a structured type", len(raw))
+	s.client.log(LevelDebug, "parsed stationboard response", "bytes", len(raw))
 	return &stbResp, err
 }
@@ -51,6 +51,7 @@ func TestStationboardService_SearchWithOpts(t *testing.T) {
 	// check the returned struct against a static struct
 	var staticResult StationboardResult
 	_ = json.Unmarshal(fixture, &staticResult)
+	staticResult.FetchedAt = stbResult.FetchedAt
 
 	if got, want := stbResult, &staticResult; !reflect.DeepEqual(got, want) {
 		t.Errorf("The proceeded response does not equals the static fixture")
@@ -105,6 +106,7 @@ func TestStationboardService_SearchWithTransportations(t *testing.T) {
 	// check the returned struct against a static struct
 	var staticResult StationboardResult
 	_ = json.Unmarshal(fixture, &staticResult)
+	staticResult.FetchedAt = stbResult.FetchedAt
 
 	if got, want := stbResult, &staticResult; !reflect.DeepEqual(got, want) {
 		t.Errorf("The proceeded response does not equals the static fixture")
@@ -115,7 +117,7 @@ func TestStationboardService_buildUrlPath(t *testing.T) {
 
 	stbSvc := newStationboardService(nil)
 
-	inputDate, _ := time.Parse("2006-01-02 15:04", "2020-05-02 02:00")
+	inputDate, _ := time.ParseInLocation("2006-01-02 15:04", "2020-05-02 02:00", swissLocation())
 	stbOpts := StbOpts{
 		Transportations: nil,
 		DateTime:        inputDate,
@@ -200,4 +202,14 @@ func TestStationboardService_parseResponseError(t *testing.T) {
 			t.Errorf("The response parser got error message '%s' but want '%s'", got, want)
 		}
 	}
-}
\ No newline at end of file
+}
+
+func TestStationboardService_formatDate(t *testing.T) {
+	stbSvc := newStationboardService(nil)
+
+	// 23:30 UTC is already the next day in switzerland
+	d := time.Date(2020, 1, 15, 23, 30, 0, 0, time.UTC)
+	if got, err := stbSvc.formatDate(d); err != nil || got != "2020-01-16 00:30" {
+		t.Errorf("Got formatted date %s instead of the swiss time 2020-01-16 00:30", got)
+	}
+}
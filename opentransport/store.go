@@ -0,0 +1,189 @@
+package opentransport
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// A Store keeps the last successful response of every query. It is used by the offline mode
+// to serve responses while the API is unreachable. Implementations have to be safe for concurrent use.
+type Store interface {
+	// Returns the last response stored for the key and true, or false if there is none.
+	Load(key string) (CacheEntry, bool)
+
+	// Stores the response for the key and replaces an existing one.
+	Save(key string, entry CacheEntry) error
+}
+
+// A Store which keeps every response as a json file within a directory.
+type FileStore struct {
+	mu  sync.RWMutex
+	dir string
+}
+
+// The file format of a stored response
+type storedResponse struct {
+	Key      string    `json:"key"`
+	StoredAt time.Time `json:"storedAt"`
+	Body     []byte    `json:"body"`
+}
+
+// Creates a new file store within the directory. The directory will be created if it does not exist.
+//
+// Returns a pointer to a FileStore and an error if the directory is not accessible
+func NewFileStore(dir string) (*FileStore, error) {
+	if len(dir) == 0 {
+		return nil, errors.New("the store directory can not be empty")
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create store directory: %w", err)
+	}
+
+	return &FileStore{dir: dir}, nil
+}
+
+// Reads the last response of the key from its file.
+func (s *FileStore) Load(key string) (CacheEntry, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	raw, err := ioutil.ReadFile(s.path(key))
+	if err != nil {
+		return CacheEntry{}, false
+	}
+
+	var stored storedResponse
+	if err := json.Unmarshal(raw, &stored); err != nil || stored.Key != key {
+		return CacheEntry{}, false
+	}
+
+	return CacheEntry{Body: stored.Body, StoredAt: stored.StoredAt}, true
+}
+
+// Writes the response to the file of the key. The file is replaced atomically.
+func (s *FileStore) Save(key string, entry CacheEntry) error {
+	raw, err := json.Marshal(storedResponse{Key: key, StoredAt: entry.StoredAt, Body: entry.Body})
+	if err != nil {
+		return fmt.Errorf("failed to encode response: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tmp, err := ioutil.TempFile(s.dir, "response-*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create response file: %w", err)
+	}
+
+	if _, err := tmp.Write(raw); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write response file: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write response file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), s.path(key)); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("failed to replace response file: %w", err)
+	}
+	return nil
+}
+
+// Removes the responses which were stored before the maximum age, e.g. of queries which are not used anymore.
+//
+// Returns the amount of removed responses and an error if the directory can not be read
+func (s *FileStore) Prune(maxAge time.Duration) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	files, err := filepath.Glob(filepath.Join(s.dir, "*.json"))
+	if err != nil {
+		return 0, fmt.Errorf("failed to read store directory: %w", err)
+	}
+
+	deadline := time.Now().Add(-maxAge)
+	removed := 0
+	for _, file := range files {
+		raw, err := ioutil.ReadFile(file)
+		if err != nil {
+			continue
+		}
+
+		var stored storedResponse
+		if err := json.Unmarshal(raw, &stored); err != nil || !stored.StoredAt.Before(deadline) {
+			continue
+		}
+
+		if err := os.Remove(file); err != nil {
+			return removed, fmt.Errorf("failed to remove response file: %w", err)
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// Returns the file path of a key
+func (s *FileStore) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(s.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// The query parameters which are not part of the key of the offline store. The services send the current time
+// with most queries, so a stored response would never match again without removing it.
+var storeIgnoredParams = []string{"date", "time", "datetime"}
+
+// Removes the date and time parameters of a cache key, so that the offline store keeps the last response
+// of a query regardless of its time.
+//
+// Returns the key of the offline store
+func storeKey(key string) string {
+	parts := strings.SplitN(key, "?", 2)
+	if len(parts) < 2 {
+		return key
+	}
+
+	query, err := url.ParseQuery(parts[1])
+	if err != nil {
+		return key
+	}
+	for _, p := range storeIgnoredParams {
+		query.Del(p)
+	}
+
+	if len(query) == 0 {
+		return parts[0]
+	}
+	return parts[0] + "?" + query.Encode()
+}
+
+// Checks if an error allows to serve a stored response. Only network errors, including timeouts,
+// server errors and an open circuit are accepted. Requests canceled by the caller are not.
+//
+// Returns true if the offline mode should be used
+func offlineFallback(err error) bool {
+	if errors.Is(err, ErrCircuitOpen) {
+		return true
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || errors.Is(err, context.Canceled) {
+		return false
+	}
+	return apiErr.StatusCode == 0 || errors.Is(err, ErrServerError)
+}
@@ -0,0 +1,197 @@
+package opentransport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// Creates a file store within a temporary directory
+func newTestFileStore(t *testing.T) (*FileStore, func()) {
+	dir, err := ioutil.TempDir("", "opentransport-store")
+	if err != nil {
+		t.Fatalf("Failed to create temporary directory: %s", err)
+	}
+
+	store, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("Failed to create file store: %s", err)
+	}
+
+	return store, func() { _ = os.RemoveAll(dir) }
+}
+
+func TestFileStore_SaveLoad(t *testing.T) {
+	store, cleanup := newTestFileStore(t)
+	defer cleanup()
+
+	storedAt := time.Date(2020, 5, 2, 20, 0, 0, 0, time.UTC)
+	if err := store.Save("stationboard?station=8591382", CacheEntry{Body: []byte(`{"stationboard":[]}`), StoredAt: storedAt}); err != nil {
+		t.Fatalf("Failed to save response: %s", err)
+	}
+
+	entry, ok := store.Load("stationboard?station=8591382")
+	if !ok {
+		t.Fatalf("The stored response could not be loaded")
+	}
+
+	if got, want := string(entry.Body), `{"stationboard":[]}`; got != want {
+		t.Errorf("The stored body is '%s' but want '%s'", got, want)
+	}
+
+	if !entry.StoredAt.Equal(storedAt) {
+		t.Errorf("The stored time is %s but want %s", entry.StoredAt, storedAt)
+	}
+
+	if _, ok := store.Load("stationboard?station=Bern"); ok {
+		t.Errorf("An unknown key should not be loaded")
+	}
+}
+
+func TestNewFileStore_EmptyDir(t *testing.T) {
+	if _, err := NewFileStore(""); err == nil {
+		t.Errorf("A file store without directory should not be created")
+	}
+}
+
+func TestClient_Offline(t *testing.T) {
+	srv, client, terminate := prepare()
+	defer terminate()
+
+	store, cleanup := newTestFileStore(t)
+	defer cleanup()
+
+	var down int32
+	srv.HandleFunc("/stationboard", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&down) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"station":{"id":"8591382"},"stationboard":[]}`)
+	})
+	srv.HandleFunc("/locations", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	client = mustWith(t, client, WithRetryPolicy(NoRetry), WithOfflineStore(store))
+
+	date := time.Date(2020, 5, 2, 20, 0, 0, 0, time.UTC)
+	fresh, err := client.Stationboard.SearchWithDate(context.Background(), "8591382", date)
+	if err != nil {
+		t.Fatalf("Failed to search stationboard: %s", err)
+	}
+
+	if fresh.Stale || fresh.FetchedAt.IsZero() {
+		t.Errorf("A fresh result should not be stale and have a fetch time")
+	}
+
+	atomic.StoreInt32(&down, 1)
+
+	stale, err := client.Stationboard.SearchWithDate(context.Background(), "8591382", date)
+	if err != nil {
+		t.Fatalf("The stored response should be served while the api is down: %s", err)
+	}
+
+	if !stale.Stale {
+		t.Errorf("A result of the offline store should be stale")
+	}
+
+	if got, want := stale.FetchedAt, fresh.FetchedAt; !got.Equal(want) {
+		t.Errorf("The stale result was fetched at %s but want %s", got, want)
+	}
+
+	if got, want := stale.Station.Id, "8591382"; got != want {
+		t.Errorf("The stale result contains station %s but want %s", got, want)
+	}
+
+	// Unknown queries still fail
+	_, err = client.Stationboard.SearchWithDate(context.Background(), "Bern", date)
+	if !errors.Is(err, ErrServerError) {
+		t.Errorf("A query without stored response should fail with a server error but got %v", err)
+	}
+
+	// Client errors are not served from the store
+	_, err = client.Location.Search(context.Background(), "Zürich")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("A client error should not use the offline mode but got %v", err)
+	}
+}
+
+func TestClient_OfflineCurrentTime(t *testing.T) {
+	srv, client, terminate := prepare()
+	defer terminate()
+
+	store, cleanup := newTestFileStore(t)
+	defer cleanup()
+
+	var down int32
+	srv.HandleFunc("/stationboard", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&down) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"station":{"id":"8591382"},"stationboard":[]}`)
+	})
+
+	client = mustWith(t, client, WithRetryPolicy(NoRetry), WithOfflineStore(store))
+
+	// A departure board queries the current time, which differs on every refresh
+	now := time.Now()
+	if _, err := client.Stationboard.SearchWithDate(context.Background(), "8591382", now); err != nil {
+		t.Fatalf("Failed to search stationboard: %s", err)
+	}
+
+	atomic.StoreInt32(&down, 1)
+
+	stale, err := client.Stationboard.SearchWithDate(context.Background(), "8591382", now.Add(7*time.Minute))
+	if err != nil {
+		t.Fatalf("The stored response should be served for a later time: %s", err)
+	}
+	if !stale.Stale {
+		t.Errorf("A result of the offline store should be stale")
+	}
+}
+
+func TestStoreKey(t *testing.T) {
+	var tests = []struct {
+		in   string
+		want string
+	}{
+		{"stationboard?datetime=2020-05-02+20%3A00&limit=3&station=Bern", "stationboard?limit=3&station=Bern"},
+		{"connections?date=2020-05-02&from=Bern&time=20%3A00&to=Olten", "connections?from=Bern&to=Olten"},
+		{"locations?query=Bern", "locations?query=Bern"},
+		{"", ""},
+	}
+
+	for _, v := range tests {
+		if got := storeKey(v.in); got != v.want {
+			t.Errorf("Got store key %s for %s but want %s", got, v.in, v.want)
+		}
+	}
+}
+
+func TestFileStore_Prune(t *testing.T) {
+	store, cleanup := newTestFileStore(t)
+	defer cleanup()
+
+	_ = store.Save("stationboard?station=Bern", CacheEntry{Body: []byte(`{}`), StoredAt: time.Now().Add(-48 * time.Hour)})
+	_ = store.Save("stationboard?station=Olten", CacheEntry{Body: []byte(`{}`), StoredAt: time.Now()})
+
+	removed, err := store.Prune(24 * time.Hour)
+	if err != nil || removed != 1 {
+		t.Fatalf("Got %d removed responses and error %v instead of 1", removed, err)
+	}
+
+	if _, ok := store.Load("stationboard?station=Bern"); ok {
+		t.Errorf("The old response should be removed")
+	}
+	if _, ok := store.Load("stationboard?station=Olten"); !ok {
+		t.Errorf("The recent response should be kept")
+	}
+}
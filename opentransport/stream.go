@@ -0,0 +1,296 @@
+package opentransport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// A response body which fails with ErrResponseTooLarge as soon as more than max bytes are read
+type limitedBody struct {
+	io.ReadCloser
+	read int64
+	max  int64
+}
+
+// Iterates lazily over the items of an array within a json object. The other fields of
+// the object are decoded into the targets of fields, unknown fields are skipped.
+type itemStream struct {
+	body    io.ReadCloser
+	dec     *json.Decoder
+	key     string
+	fields  map[string]interface{}
+	started bool
+	inArray bool
+	done    bool
+	err     error
+}
+
+// Iterates lazily over the connections of a response. Use ConnectionService.Stream to query
+// connections or NewConnectionIterator to iterate over a response of another source.
+//
+//	it, err := client.Connection.Stream(ctx, "Zürich HB", "Bern", time.Now(), &opentransport.ConnOpts{})
+//	if err != nil {
+//		return err
+//	}
+//	defer it.Close()
+//
+//	for it.Next() {
+//		fmt.Println(it.Connection().From.Departure.Time)
+//	}
+//	return it.Err()
+type ConnectionIterator struct {
+	stream  *itemStream
+	current Connection
+	result  ConnectionResult
+}
+
+// Iterates lazily over the journeys of a stationboard response. Use StationboardService.Stream to query
+// a stationboard or NewStationboardIterator to iterate over a response of another source.
+type StationboardIterator struct {
+	stream  *itemStream
+	current StationBoardJourney
+	result  StationboardResult
+}
+
+// Sets the maximum size of a response body in bytes. Larger responses fail with ErrResponseTooLarge.
+// Default is 0, which means no limit.
+func WithMaxResponseSize(size int64) Option {
+	return func(cfg *clientConfig) error {
+		if size < 0 {
+			return errors.New("the max response size can not be negative")
+		}
+		cfg.maxResponseSize = size
+		return nil
+	}
+}
+
+// Creates a request for the path and opens the body of the response. See Client.stream.
+//
+// Returns the unread body and an error if the request failed
+func (c *Client) openStream(ctx context.Context, path string) (io.ReadCloser, error) {
+	req, err := c.NewRequest(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok, err := validRequest(req); !ok {
+		return nil, fmt.Errorf("opentransport: invalid http request: %w", err)
+	}
+
+	cfg := c.config()
+	_, service := cfg.cacheKey(req)
+	return c.stream(cfg, req, service)
+}
+
+// Limits a response body to max bytes. A max of 0 means no limit.
+//
+// Returns the limited body
+func limitBody(body io.ReadCloser, max int64) io.ReadCloser {
+	if max <= 0 {
+		return body
+	}
+	return &limitedBody{ReadCloser: body, max: max}
+}
+
+// Reads from the body and fails if the limit is exceeded
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.read > b.max {
+		return 0, fmt.Errorf("%w: more than %d bytes", ErrResponseTooLarge, b.max)
+	}
+
+	n, err := b.ReadCloser.Read(p)
+	b.read += int64(n)
+	if b.read > b.max {
+		return n, fmt.Errorf("%w: more than %d bytes", ErrResponseTooLarge, b.max)
+	}
+	return n, err
+}
+
+// Creates an iterator over a json array in the field key of the json object in the body.
+func newItemStream(body io.ReadCloser, key string, fields map[string]interface{}) *itemStream {
+	return &itemStream{body: body, dec: json.NewDecoder(body), key: key, fields: fields}
+}
+
+// Decodes the next item of the array into v.
+//
+// Returns false if there are no more items or an error occurred
+func (s *itemStream) next(v interface{}) bool {
+	if s.done || s.err != nil {
+		return false
+	}
+
+	if !s.started {
+		s.started = true
+		if err := s.expect('{'); err != nil {
+			return s.fail(err)
+		}
+	}
+
+	for {
+		if s.inArray {
+			if s.dec.More() {
+				if err := s.dec.Decode(v); err != nil {
+					return s.fail(err)
+				}
+				return true
+			}
+
+			if err := s.expect(']'); err != nil {
+				return s.fail(err)
+			}
+			s.inArray = false
+		}
+
+		if !s.dec.More() {
+			if err := s.expect('}'); err != nil {
+				return s.fail(err)
+			}
+			s.done = true
+			return false
+		}
+
+		tok, err := s.dec.Token()
+		if err != nil {
+			return s.fail(err)
+		}
+		key, _ := tok.(string)
+
+		if key == s.key {
+			if err := s.openArray(); err != nil {
+				return s.fail(err)
+			}
+			continue
+		}
+
+		// Decode the other fields into their targets or skip them
+		var target interface{} = new(json.RawMessage)
+		if t, ok := s.fields[key]; ok {
+			target = t
+		}
+		if err := s.dec.Decode(target); err != nil {
+			return s.fail(err)
+		}
+	}
+}
+
+// Reads the start of the array. A null value is treated as empty array.
+func (s *itemStream) openArray() error {
+	tok, err := s.dec.Token()
+	if err != nil {
+		return err
+	}
+
+	if tok == nil {
+		return nil
+	}
+
+	if d, ok := tok.(json.Delim); !ok || d != '[' {
+		return fmt.Errorf("the field %s is not an array", s.key)
+	}
+	s.inArray = true
+	return nil
+}
+
+// Reads the next token and checks if it is the delimiter
+func (s *itemStream) expect(delim json.Delim) error {
+	tok, err := s.dec.Token()
+	if err != nil {
+		return err
+	}
+
+	if d, ok := tok.(json.Delim); !ok || d != delim {
+		return fmt.Errorf("unexpected token %v, want %s", tok, delim)
+	}
+	return nil
+}
+
+// Stops the iteration with an error
+func (s *itemStream) fail(err error) bool {
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	s.err = fmt.Errorf("failed to decode response: %w", err)
+	return false
+}
+
+// Creates an iterator over the connections of a json response, e.g. of a file or a fake server.
+// The iterator reads the body lazily and closes it with Close.
+//
+// Returns a pointer to a ConnectionIterator
+func NewConnectionIterator(body io.ReadCloser) *ConnectionIterator {
+	it := &ConnectionIterator{}
+	it.stream = newItemStream(body, "connections", map[string]interface{}{
+		"from":     &it.result.From,
+		"to":       &it.result.To,
+		"stations": &it.result.Stations,
+	})
+	return it
+}
+
+// Decodes the next connection. Returns false if there are no more connections or an error occurred.
+func (it *ConnectionIterator) Next() bool {
+	it.current = Connection{}
+	return it.stream.next(&it.current)
+}
+
+// Returns the current connection
+func (it *ConnectionIterator) Connection() Connection {
+	return it.current
+}
+
+// Returns the fields of the response without the connections. The API returns these fields after
+// the connections, so they are only complete after Next returned false.
+func (it *ConnectionIterator) Result() ConnectionResult {
+	return it.result
+}
+
+// Returns the error which stopped the iteration or nil
+func (it *ConnectionIterator) Err() error {
+	return it.stream.err
+}
+
+// Closes the response body
+func (it *ConnectionIterator) Close() error {
+	return it.stream.body.Close()
+}
+
+// Creates an iterator over the journeys of a json stationboard response, e.g. of a file or a fake server.
+// The iterator reads the body lazily and closes it with Close.
+//
+// Returns a pointer to a StationboardIterator
+func NewStationboardIterator(body io.ReadCloser) *StationboardIterator {
+	it := &StationboardIterator{}
+	it.stream = newItemStream(body, "stationboard", map[string]interface{}{
+		"station": &it.result.Station,
+	})
+	return it
+}
+
+// Decodes the next journey. Returns false if there are no more journeys or an error occurred.
+func (it *StationboardIterator) Next() bool {
+	it.current = StationBoardJourney{}
+	return it.stream.next(&it.current)
+}
+
+// Returns the current journey
+func (it *StationboardIterator) Journey() StationBoardJourney {
+	return it.current
+}
+
+// Returns the station of the stationboard, which is available after the first call of Next.
+func (it *StationboardIterator) Station() Location {
+	return it.result.Station
+}
+
+// Returns the error which stopped the iteration or nil
+func (it *StationboardIterator) Err() error {
+	return it.stream.err
+}
+
+// Closes the response body
+func (it *StationboardIterator) Close() error {
+	return it.stream.body.Close()
+}
@@ -0,0 +1,170 @@
+package opentransport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConnectionIterator(t *testing.T) {
+	fixture, _ := readFixture("connection_search")
+
+	var want ConnectionResult
+	if err := json.Unmarshal(fixture, &want); err != nil {
+		t.Fatalf("Failed to parse the fixture: %s", err)
+	}
+
+	it := NewConnectionIterator(ioutil.NopCloser(strings.NewReader(string(fixture))))
+	defer it.Close()
+
+	var got []Connection
+	for it.Next() {
+		got = append(got, it.Connection())
+	}
+
+	if err := it.Err(); err != nil {
+		t.Fatalf("Failed to iterate over the connections: %s", err)
+	}
+
+	if !reflect.DeepEqual(got, want.Connections) {
+		t.Errorf("The iterator returned other connections than json.Unmarshal")
+	}
+
+	result := it.Result()
+	if result.From.Name != want.From.Name || result.To.Name != want.To.Name || len(result.Stations.From) != len(want.Stations.From) {
+		t.Errorf("The fields after the connections should be decoded but got %+v", result)
+	}
+
+	if it.Next() {
+		t.Errorf("A finished iterator should not return more connections")
+	}
+}
+
+func TestStationboardIterator_Invalid(t *testing.T) {
+	testValues := []struct {
+		in    string
+		items int
+		fails bool
+	}{
+		{`{"station":{"name":"Bern"},"stationboard":[{"name":"IC 1"},{"name":"IR 2"}]}`, 2, false},
+		{`{"stationboard":null,"station":{"name":"Bern"}}`, 0, false},
+		{`{"stationboard":[]}`, 0, false},
+		{`{"stationboard":[{"name":"IC 1"},`, 1, true},
+		{`{"stationboard":{}}`, 0, true},
+		{`[]`, 0, true},
+		{``, 0, true},
+	}
+
+	for _, tv := range testValues {
+		it := NewStationboardIterator(ioutil.NopCloser(strings.NewReader(tv.in)))
+
+		items := 0
+		for it.Next() {
+			items++
+		}
+
+		if items != tv.items {
+			t.Errorf("The iterator returned %d journeys of %s but want %d", items, tv.in, tv.items)
+		}
+
+		if got := it.Err() != nil; got != tv.fails {
+			t.Errorf("The iteration over %s failed %t but want %t: %v", tv.in, got, tv.fails, it.Err())
+		}
+		_ = it.Close()
+	}
+}
+
+func TestStationboardService_Stream(t *testing.T) {
+	client, fixture, teardown := setupStationBoardTests(t)
+	defer teardown()
+
+	var want StationboardResult
+	_ = json.Unmarshal(fixture, &want)
+
+	opts := StbOpts{DateTime: time.Now(), Limit: 15}
+	it, err := client.Stationboard.Stream(context.Background(), "Zürich HB", opts)
+	if err != nil {
+		t.Fatalf("Failed to stream the stationboard: %s", err)
+	}
+	defer it.Close()
+
+	count := 0
+	for it.Next() {
+		if got, want := it.Journey().Name, want.Journeys[count].Name; got != want {
+			t.Errorf("The journey %d is %s but want %s", count, got, want)
+		}
+		count++
+	}
+
+	if err := it.Err(); err != nil {
+		t.Fatalf("Failed to iterate over the journeys: %s", err)
+	}
+
+	if count != len(want.Journeys) {
+		t.Errorf("The iterator returned %d journeys but want %d", count, len(want.Journeys))
+	}
+
+	if got, want := it.Station().Name, want.Station.Name; got != want {
+		t.Errorf("The stationboard has the station %s but want %s", got, want)
+	}
+}
+
+func TestClient_MaxResponseSize(t *testing.T) {
+	mux, client, teardown := prepare()
+	defer teardown()
+
+	fixture, _ := readFixture("connection_search")
+	mux.HandleFunc("/connections", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(fixture)
+	})
+
+	if _, err := client.With(WithMaxResponseSize(-1)); err == nil {
+		t.Errorf("A negative max response size should return an error")
+	}
+
+	client = mustWith(t, client, WithMaxResponseSize(1024), WithRetryPolicy(NoRetry))
+
+	_, err := client.Connection.Search(context.Background(), "Zürich", "Bern", time.Now())
+	if !errors.Is(err, ErrResponseTooLarge) {
+		t.Errorf("A large response should fail with ErrResponseTooLarge but got %v", err)
+	}
+
+	it, err := client.Connection.Stream(context.Background(), "Zürich", "Bern", time.Now(), &ConnOpts{})
+	if err != nil {
+		t.Fatalf("Failed to open the stream: %s", err)
+	}
+	defer it.Close()
+
+	for it.Next() {
+	}
+
+	if !errors.Is(it.Err(), ErrResponseTooLarge) {
+		t.Errorf("A large stream should fail with ErrResponseTooLarge but got %v", it.Err())
+	}
+
+	// Without a limit, the same response is accepted
+	client = mustWith(t, client, WithMaxResponseSize(0))
+	if _, err := client.Connection.Search(context.Background(), "Zürich", "Bern", time.Now()); err != nil {
+		t.Errorf("A response without a limit should be accepted: %s", err)
+	}
+}
+
+func TestConnectionService_StreamError(t *testing.T) {
+	mux, client, teardown := prepare()
+	defer teardown()
+
+	mux.HandleFunc("/connections", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.Connection.Stream(context.Background(), "Zürich", "Bern", time.Now(), &ConnOpts{})
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("A failed stream should return ErrNotFound but got %v", err)
+	}
+}
@@ -0,0 +1,122 @@
+package opentransport
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// The date format of the API, which is ISO 8601 without a colon in the offset
+const timestampFormat = "2006-01-02T15:04:05Z0700"
+
+// A point in time of the API, e.g. the departure of a stop. The zero value is null.
+// The offset of the API is kept, use Swiss to get the time in the time zone of switzerland.
+type Timestamp struct {
+	time.Time
+}
+
+// Creates a timestamp of a time.Time, e.g. for a fake result in a test.
+//
+// Returns a Timestamp, which is null if the time is zero
+func NewTimestamp(t time.Time) Timestamp {
+	return Timestamp{Time: t}
+}
+
+// Reports whether the API returned a time. False means the time is unknown.
+func (t Timestamp) Valid() bool {
+	return !t.IsZero()
+}
+
+// Returns the time in the time zone Europe/Zurich or the zero time if the timestamp is null
+func (t Timestamp) Swiss() time.Time {
+	if t.IsZero() {
+		return time.Time{}
+	}
+	return t.In(swissLocation())
+}
+
+// Encodes the time in the format of the API or null if the timestamp is null
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + t.Format(timestampFormat) + `"`), nil
+}
+
+// Parse date fields from format 2006-01-02T15:04:05Z0700 or RFC 3339 to time.Time. When
+// the field is null, the timestamp will be zero. Returns an error if a
+// invalid date format will be provided.
+func (t *Timestamp) UnmarshalJSON(raw []byte) error {
+	i := strings.TrimSpace(string(raw))
+
+	// Check if the string contains an empty string or `null`
+	if i == "null" || len(i) == 0 {
+		*t = Timestamp{}
+		return nil
+	}
+
+	return t.UnmarshalText([]byte(strings.Trim(i, `"`)))
+}
+
+// Encodes the time in the format of the API or an empty text if the timestamp is null
+func (t Timestamp) MarshalText() ([]byte, error) {
+	if t.IsZero() {
+		return []byte{}, nil
+	}
+	return []byte(t.Format(timestampFormat)), nil
+}
+
+// Parses a time in the format of the API or RFC 3339. An empty text is null.
+func (t *Timestamp) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*t = Timestamp{}
+		return nil
+	}
+
+	parsed, err := parseTimestamp(string(text))
+	if err != nil {
+		return err
+	}
+	t.Time = parsed
+	return nil
+}
+
+// Reads a timestamp of a database column, which can be a time, a string in the format of the API or null
+func (t *Timestamp) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*t = Timestamp{}
+		return nil
+	case time.Time:
+		t.Time = v
+		return nil
+	case string:
+		return t.UnmarshalText([]byte(v))
+	case []byte:
+		return t.UnmarshalText(v)
+	}
+	return fmt.Errorf("cannot scan %T into a timestamp", src)
+}
+
+// Returns the time for a database column or nil if the timestamp is null
+func (t Timestamp) Value() (driver.Value, error) {
+	if t.IsZero() {
+		return nil, nil
+	}
+	return t.Time, nil
+}
+
+// Parses a date in the format of the API. Dates marshaled by time.Time are accepted as well.
+//
+// Returns the time and the error of the format of the API if both formats fail
+func parseTimestamp(s string) (time.Time, error) {
+	parsed, err := time.Parse(timestampFormat, s)
+	if err != nil {
+		var rfcErr error
+		if parsed, rfcErr = time.Parse(time.RFC3339Nano, s); rfcErr != nil {
+			return time.Time{}, err
+		}
+	}
+	return parsed, nil
+}
@@ -0,0 +1,142 @@
+package opentransport
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTimestamp_UnmarshalJSON(t *testing.T) {
+	want := time.Date(2019, 3, 31, 8, 58, 0, 0, time.FixedZone("", 2*60*60))
+
+	for _, raw := range []string{`"2019-03-31T08:58:00+0200"`, `"2019-03-31T08:58:00+02:00"`} {
+		var d Timestamp
+		if err := d.UnmarshalJSON([]byte(raw)); err != nil {
+			t.Errorf("Failed to parse %s: %s", raw, err)
+		}
+		if !d.Equal(want) {
+			t.Errorf("Got %s instead of %s", d.Time, want)
+		}
+	}
+
+	var d Timestamp
+	if err := d.UnmarshalJSON([]byte(`null`)); err != nil || !d.IsZero() {
+		t.Errorf("A null date should be zero")
+	}
+	if err := d.UnmarshalJSON([]byte(`"31.03.2019"`)); err == nil {
+		t.Errorf("An invalid date should be an error")
+	}
+}
+
+func TestTimestamp_MarshalJSON(t *testing.T) {
+	fixture, err := readFixture("connection_search")
+	if err != nil {
+		t.Fatalf("Could not read fixture: %s", err)
+	}
+
+	var result ConnectionResult
+	if err := json.Unmarshal(fixture, &result); err != nil {
+		t.Fatalf("Failed to parse fixture: %s", err)
+	}
+
+	raw, err := json.Marshal(result)
+	if err != nil {
+		t.Fatalf("Failed to encode result: %s", err)
+	}
+
+	if strings.Contains(string(raw), `"Time"`) {
+		t.Errorf("A timestamp was encoded as object")
+	}
+	if !strings.Contains(string(raw), `"arrival":null`) || !strings.Contains(string(raw), `"departure":"2020-04-26T22:53:00+0200"`) {
+		t.Errorf("The timestamps were not encoded in the format of the API")
+	}
+
+	var decoded ConnectionResult
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		t.Fatalf("Failed to decode the encoded result: %s", err)
+	}
+	if !reflect.DeepEqual(decoded, result) {
+		t.Errorf("The result changed after a json round trip")
+	}
+}
+
+func TestTimestamp_Text(t *testing.T) {
+	ts := NewTimestamp(time.Date(2020, 4, 26, 20, 53, 0, 0, time.UTC))
+
+	text, err := ts.MarshalText()
+	if err != nil || string(text) != "2020-04-26T20:53:00Z" {
+		t.Errorf("Got text %s instead of 2020-04-26T20:53:00Z", text)
+	}
+
+	var got Timestamp
+	if err := got.UnmarshalText(text); err != nil || !got.Equal(ts.Time) {
+		t.Errorf("Got %s instead of %s", got, ts)
+	}
+
+	if text, _ := (Timestamp{}).MarshalText(); len(text) != 0 {
+		t.Errorf("A null timestamp should be an empty text")
+	}
+}
+
+func TestTimestamp_Scan(t *testing.T) {
+	want := time.Date(2020, 4, 26, 22, 53, 0, 0, time.FixedZone("", 2*60*60))
+
+	for _, src := range []interface{}{want, "2020-04-26T22:53:00+0200", []byte("2020-04-26T22:53:00+02:00")} {
+		var got Timestamp
+		if err := got.Scan(src); err != nil || !got.Equal(want) {
+			t.Errorf("Scanned %v to %s instead of %s", src, got, want)
+		}
+	}
+
+	got := NewTimestamp(want)
+	if err := got.Scan(nil); err != nil || got.Valid() {
+		t.Errorf("Scanning null should result in a null timestamp")
+	}
+	if err := got.Scan(42); err == nil {
+		t.Errorf("Scanning a number should return an error")
+	}
+
+	if v, err := (Timestamp{}).Value(); err != nil || v != nil {
+		t.Errorf("A null timestamp should be stored as null")
+	}
+	if v, err := NewTimestamp(want).Value(); err != nil || v != want {
+		t.Errorf("Got value %v instead of %s", v, want)
+	}
+}
+
+func TestTimestamp_Swiss(t *testing.T) {
+	ts := NewTimestamp(time.Date(2020, 1, 15, 7, 0, 0, 0, time.UTC))
+
+	if got := ts.Swiss(); got.Location() != swissLocation() || got.Hour() != 8 {
+		t.Errorf("Got %s instead of 08:00 in switzerland", got)
+	}
+	if got := (Timestamp{}).Swiss(); !got.IsZero() {
+		t.Errorf("A null timestamp should stay zero")
+	}
+}
+
+func TestSwissLocation_Bundled(t *testing.T) {
+	loc, err := time.LoadLocationFromTZData("Europe/Zurich", []byte(zurichTZData))
+	if err != nil {
+		t.Fatalf("The bundled zoneinfo is invalid: %s", err)
+	}
+
+	var tests = []struct {
+		in   time.Time
+		want int
+	}{
+		{time.Date(2020, 1, 15, 12, 0, 0, 0, time.UTC), 1},
+		{time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC), 2},
+		{time.Date(2020, 3, 29, 0, 59, 0, 0, time.UTC), 1},
+		{time.Date(2020, 3, 29, 1, 0, 0, 0, time.UTC), 2},
+		{time.Date(2036, 7, 1, 12, 0, 0, 0, time.UTC), 2},
+	}
+
+	for _, v := range tests {
+		if _, offset := v.in.In(loc).Zone(); offset != v.want*60*60 {
+			t.Errorf("Got offset %ds for %s instead of %dh", offset, v.in, v.want)
+		}
+	}
+}
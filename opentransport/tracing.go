@@ -0,0 +1,112 @@
+package opentransport
+
+import (
+	"context"
+)
+
+// A Tracer creates spans for the calls of the services and for every http attempt. It can be
+// implemented with a small adapter around an OpenTelemetry tracer. Implementations have to be
+// safe for concurrent use.
+type Tracer interface {
+	// Starts a span as child of the span in the context.
+	// Returns a context which contains the new span and the span itself.
+	Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span)
+}
+
+// A Span represents a traced operation.
+type Span interface {
+	// Adds attributes to the span.
+	SetAttributes(attrs ...Attribute)
+
+	// Marks the span as failed.
+	RecordError(err error)
+
+	// Completes the span.
+	End()
+}
+
+// A key value pair which describes a span. The value is a string, an int, a bool or a string slice.
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// The names of the spans created by the client
+const (
+	SpanLocationSearch     = "opentransport.location.search"
+	SpanConnectionSearch   = "opentransport.connection.search"
+	SpanStationboardSearch = "opentransport.stationboard.search"
+	SpanInfo               = "opentransport.info"
+	SpanPing               = "opentransport.ping"
+	SpanHTTPAttempt        = "opentransport.http.attempt"
+)
+
+// The keys of the span attributes set by the client
+const (
+	AttrQuery           = "opentransport.query"
+	AttrFrom            = "opentransport.from"
+	AttrTo              = "opentransport.to"
+	AttrStation         = "opentransport.station"
+	AttrViaCount        = "opentransport.via.count"
+	AttrLimit           = "opentransport.limit"
+	AttrTransportations = "opentransport.transportations"
+	AttrResultCount     = "opentransport.result.count"
+	AttrStale           = "opentransport.stale"
+	AttrStream          = "opentransport.stream"
+	AttrAttempt         = "opentransport.attempt"
+	AttrHTTPMethod      = "http.method"
+	AttrHTTPPath        = "url.path"
+	AttrHTTPStatus      = "http.status_code"
+	AttrHTTPSize        = "http.response_content_length"
+)
+
+// A tracer which does not record spans. It is used if tracing is disabled.
+type nopTracer struct{}
+
+// The span of the nopTracer
+type nopSpan struct{}
+
+// Creates a span attribute.
+func Attr(key string, value interface{}) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Sets the tracer which creates spans for all services and http attempts. If the tracer is nil, tracing is disabled.
+func WithTracer(tracer Tracer) Option {
+	return func(cfg *clientConfig) error {
+		cfg.tracer = tracer
+		return nil
+	}
+}
+
+// Returns the context without a new span
+func (nopTracer) Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span) {
+	return ctx, nopSpan{}
+}
+
+// Discards the attributes
+func (nopSpan) SetAttributes(attrs ...Attribute) {}
+
+// Discards the error
+func (nopSpan) RecordError(err error) {}
+
+// Does nothing
+func (nopSpan) End() {}
+
+// Starts the span of a service call with the tracer of the current configuration.
+//
+// Returns the context with the span and the span itself
+func (c *Client) startSpan(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return c.config().tracer.Start(ctx, name, attrs...)
+}
+
+// Records the error, if there is one, and completes the span
+func endSpan(span Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+	}
+	span.End()
+}
@@ -0,0 +1,172 @@
+package opentransport
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// A tracer which records all spans for tests
+type testTracer struct {
+	mu    sync.Mutex
+	spans []*testSpan
+}
+
+type testSpan struct {
+	name   string
+	parent *testSpan
+	attrs  map[string]interface{}
+	err    error
+	ended  bool
+}
+
+type testSpanKey struct{}
+
+func (t *testTracer) Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span) {
+	parent, _ := ctx.Value(testSpanKey{}).(*testSpan)
+	span := &testSpan{name: name, parent: parent, attrs: make(map[string]interface{})}
+	span.SetAttributes(attrs...)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.spans = append(t.spans, span)
+	return context.WithValue(ctx, testSpanKey{}, span), span
+}
+
+func (s *testSpan) SetAttributes(attrs ...Attribute) {
+	for _, a := range attrs {
+		s.attrs[a.Key] = a.Value
+	}
+}
+
+func (s *testSpan) RecordError(err error) { s.err = err }
+func (s *testSpan) End()                  { s.ended = true }
+
+func TestClient_TracingSpans(t *testing.T) {
+	mux, client, teardown := prepare()
+	defer teardown()
+
+	var calls int32
+	mux.HandleFunc("/stationboard", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		fixture, _ := readFixture("stationboard_search")
+		_, _ = w.Write([]byte(fixture))
+	})
+
+	tracer := &testTracer{}
+	client = mustWith(t, client,
+		WithTracer(tracer),
+		WithRetryPolicy(ConstantBackoff{Attempts: 2, Pause: time.Millisecond}),
+	)
+
+	opts := StbOpts{DateTime: time.Now(), Limit: 5, Transportations: []Transportation{Train, Bus}}
+	result, err := client.Stationboard.SearchWithOpts(context.Background(), "Zürich HB", opts)
+	if err != nil {
+		t.Fatalf("Failed to search the stationboard: %s", err)
+	}
+
+	if got, want := len(tracer.spans), 3; got != want {
+		t.Fatalf("The client created %d spans but want %d", got, want)
+	}
+
+	call := tracer.spans[0]
+	if call.name != SpanStationboardSearch || !call.ended || call.err != nil {
+		t.Errorf("The service call should be traced by a successful span %s but got %+v", SpanStationboardSearch, call)
+	}
+
+	for key, want := range map[string]interface{}{AttrStation: "Zürich HB", AttrLimit: 5, AttrResultCount: len(result.Journeys), AttrStale: false} {
+		if got := call.attrs[key]; got != want {
+			t.Errorf("The attribute %s is %v but want %v", key, got, want)
+		}
+	}
+
+	if got, ok := call.attrs[AttrTransportations].([]string); !ok || len(got) != 2 {
+		t.Errorf("The transportations should be set as attribute but got %v", call.attrs[AttrTransportations])
+	}
+
+	for i, attempt := range tracer.spans[1:] {
+		if attempt.name != SpanHTTPAttempt || attempt.parent != call || !attempt.ended {
+			t.Errorf("The attempt %d should be traced by a child span of the service call", i+1)
+		}
+
+		if got, want := attempt.attrs[AttrAttempt], i+1; got != want {
+			t.Errorf("The attempt span has the attempt %v but want %v", got, want)
+		}
+	}
+
+	if got, want := tracer.spans[1].attrs[AttrHTTPStatus], http.StatusBadGateway; got != want || tracer.spans[1].err == nil {
+		t.Errorf("The first attempt should fail with status %v but got %v", want, got)
+	}
+
+	if got, want := tracer.spans[2].attrs[AttrHTTPStatus], http.StatusOK; got != want || tracer.spans[2].err != nil {
+		t.Errorf("The second attempt should succeed with status %v but got %v", want, got)
+	}
+}
+
+func TestClient_TracingError(t *testing.T) {
+	mux, client, teardown := prepare()
+	defer teardown()
+
+	mux.HandleFunc("/connections", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	tracer := &testTracer{}
+	client = mustWith(t, client, WithTracer(tracer))
+
+	opts := &ConnOpts{Via: []string{"Bern", "Olten"}, Limit: 3}
+	if _, err := client.Connection.SearchWithOpts(context.Background(), "Zürich", "Genève", time.Now(), opts); err == nil {
+		t.Fatalf("The connection search should fail")
+	}
+
+	call := tracer.spans[0]
+	if call.err == nil || !call.ended {
+		t.Errorf("The error should be recorded in the span of the service call")
+	}
+
+	for key, want := range map[string]interface{}{AttrFrom: "Zürich", AttrTo: "Genève", AttrViaCount: 2, AttrLimit: 3} {
+		if got := call.attrs[key]; got != want {
+			t.Errorf("The attribute %s is %v but want %v", key, got, want)
+		}
+	}
+}
+
+func TestClient_TracingPing(t *testing.T) {
+	_, client, teardown := prepare()
+	defer teardown()
+
+	tracer := &testTracer{}
+	client = mustWith(t, client, WithTracer(tracer))
+
+	if _, err := client.Info.Ping(context.Background()); err != nil {
+		t.Fatalf("Failed to ping the api: %s", err)
+	}
+
+	if len(tracer.spans) != 2 {
+		t.Fatalf("Got %d spans instead of a ping and an http attempt span", len(tracer.spans))
+	}
+	if got := tracer.spans[0]; got.name != SpanPing || !got.ended {
+		t.Errorf("Got span %s instead of the ping span", got.name)
+	}
+	if got := tracer.spans[1]; got.name != SpanHTTPAttempt || got.parent != tracer.spans[0] {
+		t.Errorf("The http attempt should be a child of the ping span")
+	}
+}
+
+func TestClient_TracerNil(t *testing.T) {
+	_, client, teardown := prepare()
+	defer teardown()
+
+	client = mustWith(t, client, WithTracer(&testTracer{}))
+	client = mustWith(t, client, WithTracer(nil))
+
+	if _, err := client.Info.Info(context.Background()); err != nil {
+		t.Errorf("Failed to query the api without a tracer: %s", err)
+	}
+}
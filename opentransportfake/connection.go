@@ -0,0 +1,83 @@
+package opentransportfake
+
+import (
+	"context"
+	"time"
+
+	"github.com/minderjan/opentransport-client/opentransport"
+)
+
+// A programmable fake of the connection service.
+type ConnectionSearcher struct {
+	recorder
+
+	// The canned result of all searches. A nil result is returned as empty result.
+	Result *opentransport.ConnectionResult
+
+	// An error returned by all calls, e.g. opentransport.ErrNotFound.
+	Err error
+
+	// Computes the result of all searches and streams instead of Result, if set. The options are never nil.
+	SearchFunc func(ctx context.Context, from string, to string, date time.Time, opts *opentransport.ConnOpts) (*opentransport.ConnectionResult, error)
+}
+
+var _ opentransport.ConnectionSearcher = (*ConnectionSearcher)(nil)
+
+// Records the call and returns the connections
+func (f *ConnectionSearcher) Search(ctx context.Context, from string, to string, date time.Time) (*opentransport.ConnectionResult, error) {
+	if err := f.call(ctx, f.Err, "Search", from, to, date); err != nil {
+		return nil, err
+	}
+	return f.search(ctx, from, to, date, &opentransport.ConnOpts{})
+}
+
+// Records the call and returns the connections
+func (f *ConnectionSearcher) SearchVia(ctx context.Context, from string, to string, date time.Time, via []string) (*opentransport.ConnectionResult, error) {
+	if err := f.call(ctx, f.Err, "SearchVia", from, to, date, via); err != nil {
+		return nil, err
+	}
+	return f.search(ctx, from, to, date, &opentransport.ConnOpts{Via: via})
+}
+
+// Records the call and returns the connections
+func (f *ConnectionSearcher) SearchWithOpts(ctx context.Context, from string, to string, date time.Time, opts *opentransport.ConnOpts) (*opentransport.ConnectionResult, error) {
+	if err := f.call(ctx, f.Err, "SearchWithOpts", from, to, date, opts); err != nil {
+		return nil, err
+	}
+	return f.search(ctx, from, to, date, opts)
+}
+
+// Records the call and returns an iterator over the connections
+func (f *ConnectionSearcher) Stream(ctx context.Context, from string, to string, date time.Time, opts *opentransport.ConnOpts) (*opentransport.ConnectionIterator, error) {
+	if err := f.call(ctx, f.Err, "Stream", from, to, date, opts); err != nil {
+		return nil, err
+	}
+
+	result, err := f.search(ctx, from, to, date, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := body(result)
+	if err != nil {
+		return nil, err
+	}
+	return opentransport.NewConnectionIterator(b), nil
+}
+
+// Returns the result of SearchFunc or a copy of the canned result
+func (f *ConnectionSearcher) search(ctx context.Context, from string, to string, date time.Time, opts *opentransport.ConnOpts) (*opentransport.ConnectionResult, error) {
+	if opts == nil {
+		opts = &opentransport.ConnOpts{}
+	}
+	if f.SearchFunc != nil {
+		return f.SearchFunc(ctx, from, to, date, opts)
+	}
+
+	result := opentransport.ConnectionResult{}
+	if f.Result != nil {
+		result = *f.Result
+		result.Connections = append([]opentransport.Connection(nil), f.Result.Connections...)
+	}
+	return &result, nil
+}
@@ -0,0 +1,61 @@
+package opentransportfake
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/minderjan/opentransport-client/opentransport"
+)
+
+func TestConnectionSearcher(t *testing.T) {
+	var want opentransport.ConnectionResult
+	if err := json.Unmarshal(readFixture(t, "connection_search"), &want); err != nil {
+		t.Fatal(err)
+	}
+
+	var searcher opentransport.ConnectionSearcher
+	fake := &ConnectionSearcher{Result: &want}
+	searcher = fake
+
+	date := time.Now()
+	result, err := searcher.SearchVia(context.Background(), "Zürich HB", "Bern", date, []string{"Olten"})
+	if err != nil || len(result.Connections) != len(want.Connections) {
+		t.Fatalf("Got %v instead of the canned result", err)
+	}
+
+	// The stream decodes the same connections
+	it, err := searcher.Stream(context.Background(), "Zürich HB", "Bern", date, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer it.Close()
+
+	i := 0
+	for ; it.Next(); i++ {
+		got := it.Connection()
+		if !got.From.Departure.Equal(want.Connections[i].From.Departure.Time) || got.Duration != want.Connections[i].Duration {
+			t.Errorf("The streamed connection %d differs from the canned one", i)
+		}
+	}
+	if it.Err() != nil || i != len(want.Connections) {
+		t.Errorf("Got %d streamed connections instead of %d: %v", i, len(want.Connections), it.Err())
+	}
+
+	fake.FailNext(opentransport.ErrServerError)
+	if _, err := searcher.Search(context.Background(), "Zürich HB", "Bern", date); err != opentransport.ErrServerError {
+		t.Errorf("Got error %v instead of the injected error", err)
+	}
+
+	calls := fake.Calls()
+	if len(calls) != 3 || calls[0].Method != "SearchVia" || calls[0].Args[3].([]string)[0] != "Olten" {
+		t.Errorf("The calls were not recorded: %+v", calls)
+	}
+
+	// Without a result, an empty result is returned
+	empty := &ConnectionSearcher{}
+	if result, err := empty.SearchWithOpts(context.Background(), "A", "B", date, nil); err != nil || result == nil || len(result.Connections) != 0 {
+		t.Errorf("Want an empty result, got %v, %v", result, err)
+	}
+}
@@ -0,0 +1,114 @@
+// Use of this source code is governed by a MIT License.
+// License that can be found in the LICENSE file.
+
+// Package opentransportfake provides programmable fakes of the opentransport services for unit tests.
+// The fakes implement the interfaces LocationSearcher, ConnectionSearcher and StationboardSearcher
+// and can be used instead of the services of an opentransport.Client.
+//
+//	type Planner struct {
+//		Connections opentransport.ConnectionSearcher
+//	}
+//
+//	fake := &opentransportfake.ConnectionSearcher{Result: &opentransport.ConnectionResult{...}}
+//	planner := Planner{Connections: fake}
+//
+//	// Fail the next call
+//	fake.FailNext(opentransport.ErrServerError)
+//
+//	// Inspect the calls
+//	calls := fake.Calls()
+//
+// Every fake returns its canned result, unless a func is set, which computes the result of a call.
+// Injected errors are returned before the canned result: first the errors of FailNext, then Err.
+// The fakes are safe for concurrent use, as long as their fields are not changed during the calls.
+package opentransportfake
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"sync"
+)
+
+// A call of a fake with the name of the method and its arguments without the context.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// Records the calls of a fake and holds the injected errors.
+type recorder struct {
+	mu    sync.Mutex
+	calls []Call
+	next  []error
+}
+
+// Returns all calls in the order they were made
+func (r *recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// Returns the amount of calls of a method, e.g. "SearchWithOpts"
+func (r *recorder) CallCount(method string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	count := 0
+	for _, c := range r.calls {
+		if c.Method == method {
+			count++
+		}
+	}
+	return count
+}
+
+// Injects errors, which are returned by the next calls in the given order before Err.
+func (r *recorder) FailNext(errs ...error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.next = append(r.next, errs...)
+}
+
+// Removes all recorded calls and injected errors of FailNext
+func (r *recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+	r.next = nil
+}
+
+// Records a call and decides if it fails. A canceled context fails like a real request.
+//
+// Returns the error of the call or nil
+func (r *recorder) call(ctx context.Context, fallback error, method string, args ...interface{}) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+
+	if len(r.next) > 0 {
+		err := r.next[0]
+		r.next = r.next[1:]
+		return err
+	}
+
+	if ctx != nil && ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return fallback
+}
+
+// Encodes a result as body of a json response, which can be read by the iterators of the opentransport package.
+//
+// Returns the body and an error if the result can not be encoded
+func body(v interface{}) (io.ReadCloser, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.NopCloser(bytes.NewReader(raw)), nil
+}
@@ -0,0 +1,50 @@
+package opentransportfake
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"reflect"
+	"testing"
+)
+
+// Reads a json file of the testdata directory of the opentransport package
+func readFixture(t *testing.T, name string) []byte {
+	raw, err := ioutil.ReadFile("../opentransport/testdata/" + name + ".json")
+	if err != nil {
+		t.Fatalf("Failed to read fixture %s: %s", name, err)
+	}
+	return raw
+}
+
+func TestRecorder(t *testing.T) {
+	var r recorder
+	fallback := errors.New("fallback")
+	first, second := errors.New("first"), errors.New("second")
+
+	r.FailNext(first, second)
+	for _, want := range []error{first, second, fallback} {
+		if got := r.call(context.Background(), fallback, "Search", "Bern"); got != want {
+			t.Errorf("Got error %v instead of %v", got, want)
+		}
+	}
+
+	if got := r.CallCount("Search"); got != 3 {
+		t.Errorf("Got %d calls instead of 3", got)
+	}
+	if got, want := r.Calls()[0], (Call{Method: "Search", Args: []interface{}{"Bern"}}); !reflect.DeepEqual(got, want) {
+		t.Errorf("Got call %+v instead of %+v", got, want)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if got := r.call(ctx, nil, "Search"); got != context.Canceled {
+		t.Errorf("A canceled context should fail the call, but got %v", got)
+	}
+
+	r.FailNext(first)
+	r.Reset()
+	if len(r.Calls()) != 0 || r.call(context.Background(), nil, "Search") != nil {
+		t.Errorf("Reset should remove the calls and the injected errors")
+	}
+}
@@ -0,0 +1,74 @@
+package opentransportfake
+
+import (
+	"context"
+
+	"github.com/minderjan/opentransport-client/opentransport"
+)
+
+// A programmable fake of the location service.
+type LocationSearcher struct {
+	recorder
+
+	// The canned result of all searches.
+	Locations []opentransport.Location
+
+	// An error returned by all calls, e.g. opentransport.ErrNotFound.
+	Err error
+
+	// Computes the result of Search, SearchWithType and SearchWithOpts instead of Locations, if set.
+	SearchFunc func(ctx context.Context, name string, opts opentransport.LocOpts) ([]opentransport.Location, error)
+
+	// Computes the result of SearchWithCoordinates instead of Locations, if set.
+	CoordinatesFunc func(ctx context.Context, lat float64, long float64) ([]opentransport.Location, error)
+}
+
+var _ opentransport.LocationSearcher = (*LocationSearcher)(nil)
+
+// Records the call and returns the locations
+func (f *LocationSearcher) Search(ctx context.Context, name string) ([]opentransport.Location, error) {
+	if err := f.call(ctx, f.Err, "Search", name); err != nil {
+		return nil, err
+	}
+	return f.search(ctx, name, opentransport.LocOpts{Type: opentransport.TypeAll})
+}
+
+// Records the call and returns the locations
+func (f *LocationSearcher) SearchWithType(ctx context.Context, name string, locationType opentransport.LocationType) ([]opentransport.Location, error) {
+	if err := f.call(ctx, f.Err, "SearchWithType", name, locationType); err != nil {
+		return nil, err
+	}
+	return f.search(ctx, name, opentransport.LocOpts{Type: locationType})
+}
+
+// Records the call and returns the locations
+func (f *LocationSearcher) SearchWithOpts(ctx context.Context, name string, opts opentransport.LocOpts) ([]opentransport.Location, error) {
+	if err := f.call(ctx, f.Err, "SearchWithOpts", name, opts); err != nil {
+		return nil, err
+	}
+	return f.search(ctx, name, opts)
+}
+
+// Records the call and returns the locations
+func (f *LocationSearcher) SearchWithCoordinates(ctx context.Context, lat float64, long float64) ([]opentransport.Location, error) {
+	if err := f.call(ctx, f.Err, "SearchWithCoordinates", lat, long); err != nil {
+		return nil, err
+	}
+	if f.CoordinatesFunc != nil {
+		return f.CoordinatesFunc(ctx, lat, long)
+	}
+	return f.locations(), nil
+}
+
+// Returns the result of SearchFunc or a copy of the canned locations
+func (f *LocationSearcher) search(ctx context.Context, name string, opts opentransport.LocOpts) ([]opentransport.Location, error) {
+	if f.SearchFunc != nil {
+		return f.SearchFunc(ctx, name, opts)
+	}
+	return f.locations(), nil
+}
+
+// Returns a copy of the canned locations, so that callers can not change them
+func (f *LocationSearcher) locations() []opentransport.Location {
+	return append([]opentransport.Location(nil), f.Locations...)
+}
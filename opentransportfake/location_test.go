@@ -0,0 +1,45 @@
+package opentransportfake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/minderjan/opentransport-client/opentransport"
+)
+
+func TestLocationSearcher(t *testing.T) {
+	var searcher opentransport.LocationSearcher
+	fake := &LocationSearcher{Locations: []opentransport.Location{{Id: "8503000", Name: "Zürich HB"}}}
+	searcher = fake
+
+	locations, err := searcher.Search(context.Background(), "Zürich")
+	if err != nil || len(locations) != 1 || locations[0].Name != "Zürich HB" {
+		t.Fatalf("Got %v, %v instead of the canned locations", locations, err)
+	}
+
+	// The canned locations can not be changed by a caller
+	locations[0].Name = "Bern"
+	if fake.Locations[0].Name != "Zürich HB" {
+		t.Errorf("The canned locations were changed")
+	}
+
+	fake.SearchFunc = func(ctx context.Context, name string, opts opentransport.LocOpts) ([]opentransport.Location, error) {
+		return []opentransport.Location{{Name: name + " " + string(opts.Type)}}, nil
+	}
+	locations, _ = searcher.SearchWithType(context.Background(), "Bern", opentransport.TypeStation)
+	if got, want := locations[0].Name, "Bern station"; got != want {
+		t.Errorf("Got %s instead of %s", got, want)
+	}
+
+	fake.Err = opentransport.ErrNotFound
+	if _, err := searcher.SearchWithCoordinates(context.Background(), 47.3, 8.5); err != opentransport.ErrNotFound {
+		t.Errorf("Got error %v instead of the injected error", err)
+	}
+
+	if got := fake.CallCount("SearchWithCoordinates"); got != 1 {
+		t.Errorf("Got %d calls of SearchWithCoordinates instead of 1", got)
+	}
+	if got := len(fake.Calls()); got != 3 {
+		t.Errorf("Got %d calls instead of 3", got)
+	}
+}
@@ -0,0 +1,88 @@
+package opentransportfake
+
+import (
+	"context"
+	"time"
+
+	"github.com/minderjan/opentransport-client/opentransport"
+)
+
+// A programmable fake of the stationboard service.
+type StationboardSearcher struct {
+	recorder
+
+	// The canned result of all searches. A nil result is returned as empty result.
+	Result *opentransport.StationboardResult
+
+	// An error returned by all calls, e.g. opentransport.ErrNotFound.
+	Err error
+
+	// Computes the result of all searches and streams instead of Result, if set.
+	SearchFunc func(ctx context.Context, name string, opts opentransport.StbOpts) (*opentransport.StationboardResult, error)
+}
+
+var _ opentransport.StationboardSearcher = (*StationboardSearcher)(nil)
+
+// Records the call and returns the stationboard
+func (f *StationboardSearcher) Search(ctx context.Context, name string) (*opentransport.StationboardResult, error) {
+	if err := f.call(ctx, f.Err, "Search", name); err != nil {
+		return nil, err
+	}
+	return f.search(ctx, name, opentransport.StbOpts{DateTime: time.Now(), Limit: 15})
+}
+
+// Records the call and returns the stationboard
+func (f *StationboardSearcher) SearchWithDate(ctx context.Context, name string, date time.Time) (*opentransport.StationboardResult, error) {
+	if err := f.call(ctx, f.Err, "SearchWithDate", name, date); err != nil {
+		return nil, err
+	}
+	return f.search(ctx, name, opentransport.StbOpts{DateTime: date, Limit: 15})
+}
+
+// Records the call and returns the stationboard
+func (f *StationboardSearcher) SearchWithType(ctx context.Context, name string, date time.Time, transportations []opentransport.Transportation) (*opentransport.StationboardResult, error) {
+	if err := f.call(ctx, f.Err, "SearchWithType", name, date, transportations); err != nil {
+		return nil, err
+	}
+	return f.search(ctx, name, opentransport.StbOpts{DateTime: date, Limit: 15, Transportations: transportations})
+}
+
+// Records the call and returns the stationboard
+func (f *StationboardSearcher) SearchWithOpts(ctx context.Context, name string, opts opentransport.StbOpts) (*opentransport.StationboardResult, error) {
+	if err := f.call(ctx, f.Err, "SearchWithOpts", name, opts); err != nil {
+		return nil, err
+	}
+	return f.search(ctx, name, opts)
+}
+
+// Records the call and returns an iterator over the journeys
+func (f *StationboardSearcher) Stream(ctx context.Context, name string, opts opentransport.StbOpts) (*opentransport.StationboardIterator, error) {
+	if err := f.call(ctx, f.Err, "Stream", name, opts); err != nil {
+		return nil, err
+	}
+
+	result, err := f.search(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := body(result)
+	if err != nil {
+		return nil, err
+	}
+	return opentransport.NewStationboardIterator(b), nil
+}
+
+// Returns the result of SearchFunc or a copy of the canned result
+func (f *StationboardSearcher) search(ctx context.Context, name string, opts opentransport.StbOpts) (*opentransport.StationboardResult, error) {
+	if f.SearchFunc != nil {
+		return f.SearchFunc(ctx, name, opts)
+	}
+
+	result := opentransport.StationboardResult{}
+	if f.Result != nil {
+		result = *f.Result
+		result.Journeys = append([]opentransport.StationBoardJourney(nil), f.Result.Journeys...)
+	}
+	return &result, nil
+}
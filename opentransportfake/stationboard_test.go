@@ -0,0 +1,54 @@
+package opentransportfake
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/minderjan/opentransport-client/opentransport"
+)
+
+func TestStationboardSearcher(t *testing.T) {
+	var want opentransport.StationboardResult
+	if err := json.Unmarshal(readFixture(t, "stationboard_search"), &want); err != nil {
+		t.Fatal(err)
+	}
+
+	var searcher opentransport.StationboardSearcher
+	fake := &StationboardSearcher{Result: &want}
+	searcher = fake
+
+	result, err := searcher.Search(context.Background(), "Zürich HB")
+	if err != nil || result.Station.Name != want.Station.Name || len(result.Journeys) != len(want.Journeys) {
+		t.Fatalf("Got %v instead of the canned result", err)
+	}
+
+	it, err := searcher.Stream(context.Background(), "Zürich HB", opentransport.StbOpts{DateTime: time.Now()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer it.Close()
+
+	i := 0
+	for ; it.Next(); i++ {
+		if got := it.Journey(); got.Name != want.Journeys[i].Name || !got.Stop.Departure.Equal(want.Journeys[i].Stop.Departure.Time) {
+			t.Errorf("The streamed journey %d differs from the canned one", i)
+		}
+	}
+	if it.Err() != nil || i != len(want.Journeys) || it.Station().Name != want.Station.Name {
+		t.Errorf("Got %d streamed journeys instead of %d: %v", i, len(want.Journeys), it.Err())
+	}
+
+	fake.SearchFunc = func(ctx context.Context, name string, opts opentransport.StbOpts) (*opentransport.StationboardResult, error) {
+		return &opentransport.StationboardResult{Station: opentransport.Location{Name: name}}, nil
+	}
+	result, _ = searcher.SearchWithType(context.Background(), "Bern", time.Now(), []opentransport.Transportation{opentransport.Bus})
+	if result.Station.Name != "Bern" {
+		t.Errorf("The result of SearchFunc should be returned")
+	}
+
+	if got := fake.CallCount("SearchWithType"); got != 1 {
+		t.Errorf("Got %d calls of SearchWithType instead of 1", got)
+	}
+}
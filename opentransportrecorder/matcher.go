@@ -0,0 +1,43 @@
+package opentransportrecorder
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+)
+
+// A Matcher decides if a recorded request matches a request in replay mode.
+type Matcher func(req *http.Request, body string, recorded Request) bool
+
+// Matches the method, the url and the body, but ignores the date and time parameters, which change on every run.
+var DefaultMatcher = NewMatcher("date", "time", "datetime")
+
+// Creates a matcher, which compares the method, the scheme, the host, the path, the query and the body.
+// The ignored query parameters are not compared.
+//
+// Returns a Matcher
+func NewMatcher(ignoreParams ...string) Matcher {
+	return func(req *http.Request, body string, recorded Request) bool {
+		if req.Method != recorded.Method || body != recorded.Body {
+			return false
+		}
+
+		u, err := url.Parse(recorded.URL)
+		if err != nil {
+			return false
+		}
+		if req.URL.Scheme != u.Scheme || req.URL.Host != u.Host || req.URL.Path != u.Path {
+			return false
+		}
+
+		return reflect.DeepEqual(withoutParams(req.URL.Query(), ignoreParams), withoutParams(u.Query(), ignoreParams))
+	}
+}
+
+// Returns the query without the ignored parameters
+func withoutParams(query url.Values, ignore []string) url.Values {
+	for _, p := range ignore {
+		query.Del(p)
+	}
+	return query
+}
@@ -0,0 +1,39 @@
+package opentransportrecorder
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestNewMatcher(t *testing.T) {
+	recorded := Request{Method: http.MethodGet, URL: "http://api/v1/connections?from=Bern&to=Thun&date=2020-04-27&time=10%3A00"}
+
+	tests := []struct {
+		method string
+		url    string
+		body   string
+		want   bool
+	}{
+		{http.MethodGet, "http://api/v1/connections?from=Bern&to=Thun&date=2021-01-01&time=08%3A00", "", true},
+		{http.MethodGet, "http://api/v1/connections?to=Thun&from=Bern", "", true},
+		{http.MethodGet, "http://api/v1/connections?from=Bern&to=Spiez", "", false},
+		{http.MethodGet, "http://api/v1/connections?from=Bern&to=Thun&limit=2", "", false},
+		{http.MethodGet, "http://mirror/v1/connections?from=Bern&to=Thun", "", false},
+		{http.MethodPost, "http://api/v1/connections?from=Bern&to=Thun", "", false},
+		{http.MethodGet, "http://api/v1/connections?from=Bern&to=Thun", "body", false},
+	}
+
+	for _, tt := range tests {
+		req, _ := http.NewRequest(tt.method, tt.url, strings.NewReader(tt.body))
+		if got := DefaultMatcher(req, tt.body, recorded); got != tt.want {
+			t.Errorf("Matching %s %s returned %t instead of %t", tt.method, tt.url, got, tt.want)
+		}
+	}
+
+	strict := NewMatcher()
+	req, _ := http.NewRequest(http.MethodGet, "http://api/v1/connections?from=Bern&to=Thun&date=2021-01-01&time=08%3A00", nil)
+	if strict(req, "", recorded) {
+		t.Errorf("A matcher without ignored parameters should compare the date")
+	}
+}
@@ -0,0 +1,293 @@
+// Use of this source code is governed by a MIT License.
+// License that can be found in the LICENSE file.
+
+// Package opentransportrecorder records the http interactions of a client to cassette files and replays them,
+// so that tests against the transport API run deterministic and without network access.
+//
+//	rec, err := opentransportrecorder.New("testdata/cassettes/stationboard.json", opentransportrecorder.Options{
+//		Mode: opentransportrecorder.ModeFromEnv("OPENTRANSPORT_RECORD"),
+//	})
+//	defer rec.Stop()
+//
+//	client, err := opentransport.New(opentransport.WithHTTPClient(rec.Client()))
+//
+// In record mode, every request is sent to the API and written to the cassette by Stop. In replay mode, the
+// responses are served from the cassette. Every interaction is served once and requests without an unused
+// recorded interaction fail with ErrNoInteraction.
+package opentransportrecorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Returned in replay mode for requests without a recorded interaction. Use errors.Is to check for it.
+var ErrNoInteraction = errors.New("opentransportrecorder: no recorded interaction")
+
+// The mode of a recorder
+type Mode int
+
+const (
+	// Serves the responses from the cassette. The cassette has to exist.
+	ModeReplay Mode = iota
+
+	// Sends the requests to the API and writes them with their responses to the cassette.
+	ModeRecord
+)
+
+// The version of the cassette format
+const cassetteVersion = 1
+
+// Headers which are removed from recorded interactions by default
+var DefaultScrubHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// Configuration of a recorder
+type Options struct {
+	Mode         Mode                           // Default is ModeReplay.
+	Transport    http.RoundTripper              // Sends the requests in record mode. Default is http.DefaultTransport.
+	Matcher      Matcher                        // Finds the interaction of a request in replay mode. Default is DefaultMatcher.
+	ScrubHeaders []string                       // Headers which are removed before an interaction is saved. Default is DefaultScrubHeaders.
+	Scrub        func(interaction *Interaction) // Changes an interaction before it is saved, e.g. to remove tokens of the url.
+}
+
+// The file format of a cassette
+type Cassette struct {
+	Version      int           `json:"version"`
+	Interactions []Interaction `json:"interactions"`
+}
+
+// A request and its response
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// A recorded request
+type Request struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// A recorded response
+type Response struct {
+	StatusCode int         `json:"status"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+// A Recorder is a http.RoundTripper, which records or replays the interactions of a cassette.
+// It is safe for concurrent use.
+type Recorder struct {
+	file     string
+	opts     Options
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+// Chooses the mode by an environment variable: record if the variable is set to a non empty value other
+// than 0 or false, otherwise replay.
+//
+// Returns the mode
+func ModeFromEnv(name string) Mode {
+	switch os.Getenv(name) {
+	case "", "0", "false":
+		return ModeReplay
+	}
+	return ModeRecord
+}
+
+// Creates a recorder for the cassette file. In replay mode, the cassette is loaded and has to exist.
+//
+// Returns a pointer to a Recorder and an error if the cassette can not be loaded
+func New(file string, opts Options) (*Recorder, error) {
+	if opts.Transport == nil {
+		opts.Transport = http.DefaultTransport
+	}
+	if opts.Matcher == nil {
+		opts.Matcher = DefaultMatcher
+	}
+	if opts.ScrubHeaders == nil {
+		opts.ScrubHeaders = DefaultScrubHeaders
+	}
+
+	r := &Recorder{file: file, opts: opts, cassette: Cassette{Version: cassetteVersion}}
+	if opts.Mode == ModeRecord {
+		return r, nil
+	}
+
+	raw, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load cassette: %w", err)
+	}
+	if err := json.Unmarshal(raw, &r.cassette); err != nil {
+		return nil, fmt.Errorf("failed to parse cassette %s: %w", file, err)
+	}
+	if r.cassette.Version != cassetteVersion {
+		return nil, fmt.Errorf("unsupported version %d of cassette %s", r.cassette.Version, file)
+	}
+	r.used = make([]bool, len(r.cassette.Interactions))
+	return r, nil
+}
+
+// Returns a http client which uses the recorder as transport
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Returns the mode of the recorder
+func (r *Recorder) Mode() Mode {
+	return r.opts.Mode
+}
+
+// Returns the recorded interactions
+func (r *Recorder) Interactions() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Interaction(nil), r.cassette.Interactions...)
+}
+
+// Records or replays a request
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.opts.Mode == ModeReplay {
+		return r.replay(req, body)
+	}
+	return r.record(req, body)
+}
+
+// Writes the cassette in record mode. Replayed cassettes are not changed.
+//
+// Returns an error if the cassette can not be written
+func (r *Recorder) Stop() error {
+	if r.opts.Mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	raw, err := json.MarshalIndent(r.cassette, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed to encode cassette: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.file), 0755); err != nil {
+		return fmt.Errorf("failed to create cassette directory: %w", err)
+	}
+	if err := ioutil.WriteFile(r.file, append(raw, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+	return nil
+}
+
+// Sends the request with the transport and records it with its response
+func (r *Recorder) record(req *http.Request, body string) (*http.Response, error) {
+	res, err := r.opts.Transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	raw, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record response body: %w", err)
+	}
+
+	interaction := Interaction{
+		Request:  Request{Method: req.Method, URL: req.URL.String(), Header: req.Header.Clone(), Body: body},
+		Response: Response{StatusCode: res.StatusCode, Header: res.Header.Clone(), Body: string(raw)},
+	}
+	r.scrub(&interaction)
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	r.mu.Unlock()
+
+	res.Body = ioutil.NopCloser(bytes.NewReader(raw))
+	return res, nil
+}
+
+// Serves the first unused interaction, which matches the request. Every interaction is served once, so a
+// request which was sent more often than recorded fails, e.g. an unexpected retry.
+func (r *Recorder) replay(req *http.Request, body string) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	matched := 0
+	for i, interaction := range r.cassette.Interactions {
+		if !r.opts.Matcher(req, body, interaction.Request) {
+			continue
+		}
+		matched++
+		if !r.used[i] {
+			r.used[i] = true
+			return interaction.Response.toHTTP(req), nil
+		}
+	}
+
+	if matched > 0 {
+		return nil, fmt.Errorf("%w for %s %s in cassette %s: all %d matching interactions are used",
+			ErrNoInteraction, req.Method, req.URL, r.file, matched)
+	}
+	return nil, fmt.Errorf("%w for %s %s in cassette %s", ErrNoInteraction, req.Method, req.URL, r.file)
+}
+
+// Removes the scrubbed headers and applies the custom scrubber
+func (r *Recorder) scrub(interaction *Interaction) {
+	for _, h := range r.opts.ScrubHeaders {
+		interaction.Request.Header.Del(h)
+		interaction.Response.Header.Del(h)
+	}
+	if r.opts.Scrub != nil {
+		r.opts.Scrub(interaction)
+	}
+}
+
+// Creates a http response of a recorded response
+func (res Response) toHTTP(req *http.Request) *http.Response {
+	header := res.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", res.StatusCode, http.StatusText(res.StatusCode)),
+		StatusCode:    res.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader([]byte(res.Body))),
+		ContentLength: int64(len(res.Body)),
+		Request:       req,
+	}
+}
+
+// Reads the body of a request and restores it for the transport.
+//
+// Returns the body as string
+func readRequestBody(req *http.Request) (string, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return "", nil
+	}
+
+	raw, err := ioutil.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return "", fmt.Errorf("failed to read request body: %w", err)
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(raw))
+	return string(raw), nil
+}
@@ -0,0 +1,127 @@
+package opentransportrecorder
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Creates a temporary directory, which is removed by the returned function
+func tempDir(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "opentransportrecorder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir, func() { _ = os.RemoveAll(dir) }
+}
+
+// Sends a get request with the client and returns the status and the body
+func get(t *testing.T, c *http.Client, url string) (int, string, error) {
+	req, _ := http.NewRequest(http.MethodGet, url, nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	res, err := c.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer res.Body.Close()
+	raw, _ := ioutil.ReadAll(res.Body)
+	return res.StatusCode, string(raw), nil
+}
+
+func TestRecorder_RecordAndReplay(t *testing.T) {
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+	cassette := filepath.Join(dir, "cassettes", "stationboard.json")
+
+	hits := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.Header().Set("Set-Cookie", "session=1")
+		if r.URL.Query().Get("station") == "Nowhere" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+		_, _ = w.Write([]byte(`{"station":"` + r.URL.Query().Get("station") + `"}`))
+	}))
+	defer ts.Close()
+
+	rec, err := New(cassette, Options{Mode: ModeRecord, Scrub: func(i *Interaction) {
+		i.Request.URL = strings.Replace(i.Request.URL, "token=abc", "token=xxx", 1)
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	status, body, err := get(t, rec.Client(), ts.URL+"/v1/stationboard?station=Bern&datetime=2020-04-27+10%3A00&token=abc")
+	if err != nil || status != http.StatusOK || body != `{"station":"Bern"}` {
+		t.Fatalf("The recorded request should pass the response: %d %s %v", status, body, err)
+	}
+	_, _, _ = get(t, rec.Client(), ts.URL+"/v1/stationboard?station=Nowhere")
+	if err := rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	interactions := rec.Interactions()
+	if len(interactions) != 2 || hits != 2 {
+		t.Fatalf("Got %d interactions and %d requests instead of 2", len(interactions), hits)
+	}
+	if interactions[0].Request.Header.Get("Authorization") != "" || interactions[0].Response.Header.Get("Set-Cookie") != "" {
+		t.Errorf("The scrubbed headers should be removed")
+	}
+	if !strings.Contains(interactions[0].Request.URL, "token=xxx") {
+		t.Errorf("The custom scrubber was not applied: %s", interactions[0].Request.URL)
+	}
+
+	// The replay does not send requests and ignores the datetime parameter
+	replay, err := New(cassette, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	status, body, err = get(t, replay.Client(), ts.URL+"/v1/stationboard?station=Bern&datetime=2021-01-01+08%3A00&token=xxx")
+	if err != nil || status != http.StatusOK || body != `{"station":"Bern"}` {
+		t.Errorf("Got %d %s %v instead of the recorded response", status, body, err)
+	}
+	if status, _, _ := get(t, replay.Client(), ts.URL+"/v1/stationboard?station=Nowhere"); status != http.StatusNotFound {
+		t.Errorf("Got status %d instead of the recorded 404", status)
+	}
+
+	// Every interaction is replayed once
+	if _, _, err := get(t, replay.Client(), ts.URL+"/v1/stationboard?station=Bern&token=xxx"); !errors.Is(err, ErrNoInteraction) {
+		t.Errorf("A repeated request should fail with ErrNoInteraction, but got %v", err)
+	}
+
+	_, _, err = get(t, replay.Client(), ts.URL+"/v1/stationboard?station=Zürich")
+	if !errors.Is(err, ErrNoInteraction) {
+		t.Errorf("Got error %v instead of ErrNoInteraction", err)
+	}
+
+	if hits != 2 {
+		t.Errorf("The replay should not send requests, but the server got %d requests", hits)
+	}
+}
+
+func TestNew_MissingCassette(t *testing.T) {
+	if _, err := New("testdata/missing.json", Options{}); err == nil {
+		t.Errorf("A missing cassette should be an error in replay mode")
+	}
+	if _, err := New("testdata/missing.json", Options{Mode: ModeRecord}); err != nil {
+		t.Errorf("A missing cassette should be created in record mode: %s", err)
+	}
+}
+
+func TestModeFromEnv(t *testing.T) {
+	const name = "OPENTRANSPORTRECORDER_TEST_MODE"
+	defer os.Unsetenv(name)
+
+	for value, want := range map[string]Mode{"": ModeReplay, "0": ModeReplay, "false": ModeReplay, "1": ModeRecord, "true": ModeRecord} {
+		_ = os.Setenv(name, value)
+		if got := ModeFromEnv(name); got != want {
+			t.Errorf("Got mode %d for %q instead of %d", got, value, want)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+//go:build ignore
+// +build ignore
+
+// Generates fixtures.go out of the json files in the testdata directory of the opentransport package.
+// Run it with go generate in the directory of the opentransporttest package.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	files, err := filepath.Glob("../opentransport/testdata/*.json")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by gen_fixtures.go; DO NOT EDIT.\n\n")
+	b.WriteString("package opentransporttest\n\n")
+	b.WriteString("// The bundled fixtures, keyed by the name of the json file without extension\n")
+	b.WriteString("var bundled = map[string]string{\n")
+	for _, f := range files {
+		raw, err := ioutil.ReadFile(f)
+		if err != nil {
+			log.Fatal(err)
+		}
+		name := strings.TrimSuffix(filepath.Base(f), ".json")
+		fmt.Fprintf(&b, "\t%q: %s,\n", name, strconv.Quote(string(raw)))
+	}
+	b.WriteString("}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("fixtures.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
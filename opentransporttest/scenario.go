@@ -0,0 +1,115 @@
+package opentransporttest
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// A Scenario changes the responses of the server, e.g. to simulate an outage. Scenarios are applied in the
+// order they were injected. Every request is affected by at most one scenario.
+type Scenario struct {
+	Endpoint   Endpoint      // The affected endpoint. Default are all endpoints.
+	Times      int           // The amount of affected requests. Default is 0, which affects all requests until Reset.
+	Latency    time.Duration // Delays the response. The delay stops, if the client cancels the request.
+	Status     int           // Responds with this status and an error message instead of the fixture, e.g. 503.
+	RetryAfter time.Duration // Sets the Retry-After header in seconds, e.g. for a 429 response.
+	Malformed  bool          // Responds with invalid json, which is cut in the middle of the fixture.
+	Truncated  bool          // Closes the connection after half of the body, although the full length was announced.
+}
+
+// The next requests fail with a 503 Service Unavailable.
+//
+// Returns a Scenario
+func ServerErrors(times int) Scenario {
+	return Scenario{Times: times, Status: http.StatusServiceUnavailable}
+}
+
+// The next requests fail with a 429 Too Many Requests and a Retry-After header.
+//
+// Returns a Scenario
+func RateLimited(times int, retryAfter time.Duration) Scenario {
+	return Scenario{Times: times, Status: http.StatusTooManyRequests, RetryAfter: retryAfter}
+}
+
+// All following requests are delayed by the latency.
+//
+// Returns a Scenario
+func Latency(latency time.Duration) Scenario {
+	return Scenario{Latency: latency}
+}
+
+// The next requests respond with invalid json.
+//
+// Returns a Scenario
+func MalformedJSON(times int) Scenario {
+	return Scenario{Times: times, Malformed: true}
+}
+
+// The next requests are aborted after half of the body.
+//
+// Returns a Scenario
+func TruncatedBody(times int) Scenario {
+	return Scenario{Times: times, Truncated: true}
+}
+
+// Injects a scenario, which affects the following requests
+func (s *Server) Inject(sc Scenario) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.scenarios = append(s.scenarios, &sc)
+}
+
+// Finds the first scenario of the endpoint and counts the request. Scenarios are removed after
+// their last request. The lock has to be held by the caller.
+//
+// Returns the scenario or nil
+func (s *Server) nextScenario(endpoint Endpoint) *Scenario {
+	for i, sc := range s.scenarios {
+		if sc.Endpoint != "" && sc.Endpoint != endpoint {
+			continue
+		}
+
+		if sc.Times > 0 {
+			sc.Times--
+			if sc.Times == 0 {
+				s.scenarios = append(s.scenarios[:i:i], s.scenarios[i+1:]...)
+			}
+		}
+		applied := *sc
+		return &applied
+	}
+	return nil
+}
+
+// Writes the response of the scenario
+func (sc *Scenario) apply(w http.ResponseWriter, r *http.Request, status int, body []byte) {
+	if sc.Latency > 0 {
+		timer := time.NewTimer(sc.Latency)
+		select {
+		case <-r.Context().Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+
+	if sc.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int((sc.RetryAfter+time.Second-1)/time.Second)))
+	}
+
+	if sc.Status != 0 {
+		status = sc.Status
+		body = []byte(`{"errors":[{"message":"` + http.StatusText(status) + `"}]}`)
+	}
+
+	switch {
+	case sc.Malformed:
+		write(w, status, body[:len(body)/2])
+	case sc.Truncated:
+		w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+		write(w, status, body[:len(body)/2])
+	default:
+		write(w, status, body)
+	}
+}
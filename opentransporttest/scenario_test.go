@@ -0,0 +1,67 @@
+package opentransporttest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/minderjan/opentransport-client/opentransport"
+)
+
+func TestServer_Inject(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	c, _ := opentransport.New(opentransport.WithBaseURL(srv.URL), opentransport.WithRetryPolicy(opentransport.NoRetry))
+	ctx := context.Background()
+
+	// A burst of server errors for the connections only
+	sc := ServerErrors(2)
+	sc.Endpoint = EndpointConnections
+	srv.Inject(sc)
+
+	if _, err := c.Location.Search(ctx, "Bern"); err != nil {
+		t.Errorf("Other endpoints should not be affected: %s", err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := c.Connection.Search(ctx, "Zürich HB", "Bern", time.Now()); !errors.Is(err, opentransport.ErrServerError) {
+			t.Errorf("Got error %v instead of a server error", err)
+		}
+	}
+	if _, err := c.Connection.Search(ctx, "Zürich HB", "Bern", time.Now()); err != nil {
+		t.Errorf("The scenario should end after two requests: %s", err)
+	}
+
+	srv.Inject(RateLimited(1, 1500*time.Millisecond))
+	_, err := c.Location.Search(ctx, "Bern")
+	var apiErr *opentransport.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusTooManyRequests || apiErr.RetryAfter != 2*time.Second {
+		t.Errorf("Got error %v instead of a rate limit error with retry after", err)
+	}
+
+	srv.Inject(MalformedJSON(1))
+	if _, err := c.Location.Search(ctx, "Bern"); err == nil {
+		t.Errorf("Malformed json should fail the parsing")
+	}
+
+	srv.Inject(TruncatedBody(1))
+	if _, err := c.Location.Search(ctx, "Bern"); err == nil {
+		t.Errorf("A truncated body should fail the request")
+	}
+
+	// The latency applies to all requests until reset
+	srv.Inject(Latency(200 * time.Millisecond))
+	timeout, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	if _, err := c.Location.Search(timeout, "Bern"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Got error %v instead of an exceeded deadline", err)
+	}
+
+	srv.Reset()
+	start := time.Now()
+	if _, err := c.Location.Search(ctx, "Bern"); err != nil || time.Since(start) > 150*time.Millisecond {
+		t.Errorf("Reset should remove the latency: %v", err)
+	}
+}
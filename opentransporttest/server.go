@@ -0,0 +1,230 @@
+// Use of this source code is governed by a MIT License.
+// License that can be found in the LICENSE file.
+
+// Package opentransporttest provides an in-process fake of the transport API for tests without network access.
+// The server routes the endpoints locations, connections and stationboard by their query parameters and
+// serves bundled or user supplied fixtures. Failures like server errors, rate limits, latency, malformed
+// json and truncated bodies can be injected as scenarios.
+//
+//	srv := opentransporttest.NewServer()
+//	defer srv.Close()
+//
+//	client, err := opentransport.New(opentransport.WithBaseURL(srv.URL))
+//
+//	// The next two requests fail with a server error
+//	srv.Inject(opentransporttest.ServerErrors(2))
+//
+// The package does not depend on the opentransport package, so it can be used by tests of any package.
+package opentransporttest
+
+//go:generate go run gen_fixtures.go
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// An endpoint of the transport API
+type Endpoint string
+
+const (
+	EndpointInfo         Endpoint = ""
+	EndpointLocations    Endpoint = "locations"
+	EndpointConnections  Endpoint = "connections"
+	EndpointStationboard Endpoint = "stationboard"
+)
+
+// Names of the bundled fixtures
+const (
+	FixtureInfo                = "root"
+	FixtureLocations           = "location_search"
+	FixtureLocationCoordinates = "location_search_coordinates"
+	FixtureConnections         = "connection_search"
+	FixtureStationboard        = "stationboard_search"
+)
+
+// A fake of the transport API, which serves fixtures on a local http server.
+type Server struct {
+	// The base url of the fake API with the path /v1/, e.g. http://127.0.0.1:51234/v1/
+	URL string
+
+	ts        *httptest.Server
+	mu        sync.Mutex
+	fixtures  []fixture
+	scenarios []*Scenario
+	requests  []Request
+}
+
+// A request received by the server
+type Request struct {
+	Endpoint Endpoint
+	Query    url.Values
+	Header   http.Header
+}
+
+// A user supplied fixture, which is served if the query matches
+type fixture struct {
+	endpoint Endpoint
+	match    url.Values
+	status   int
+	body     []byte
+}
+
+// Returns a bundled fixture by its name, e.g. FixtureConnections.
+//
+// Returns the fixture and false if there is no fixture with this name
+func Fixture(name string) ([]byte, bool) {
+	f, ok := bundled[name]
+	return []byte(f), ok
+}
+
+// Starts a new fake server, which has to be closed after use.
+//
+// Returns a pointer to a Server
+func NewServer() *Server {
+	s := &Server{}
+	s.ts = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.ts.URL + "/v1/"
+	return s
+}
+
+// Stops the server and blocks until all requests are finished
+func (s *Server) Close() {
+	s.ts.Close()
+}
+
+// Serves the body for the requests of the endpoint, which contain all query values of match. A nil match
+// matches all requests of the endpoint. Fixtures which are added later take precedence.
+func (s *Server) AddFixture(endpoint Endpoint, match url.Values, body []byte) {
+	s.AddResponse(endpoint, match, http.StatusOK, body)
+}
+
+// Serves the body of a json file for the requests of the endpoint, which contain all query values of match.
+//
+// Returns an error if the file can not be read
+func (s *Server) AddFixtureFile(endpoint Endpoint, match url.Values, filename string) error {
+	body, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("failed to read fixture: %w", err)
+	}
+	s.AddFixture(endpoint, match, body)
+	return nil
+}
+
+// Serves a response with the status and the body for the requests of the endpoint, which contain all
+// query values of match, e.g. a 404 for an unknown station.
+func (s *Server) AddResponse(endpoint Endpoint, match url.Values, status int, body []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fixtures = append(s.fixtures, fixture{endpoint: endpoint, match: match, status: status, body: body})
+}
+
+// Returns all requests received by the server in the order they arrived
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// Removes all user supplied fixtures, scenarios and received requests
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fixtures = nil
+	s.scenarios = nil
+	s.requests = nil
+}
+
+// Routes a request to its endpoint and applies the scenarios
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	endpoint := Endpoint(strings.Trim(strings.TrimPrefix(r.URL.Path, "/v1"), "/"))
+	query := r.URL.Query()
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{Endpoint: endpoint, Query: query, Header: r.Header.Clone()})
+	sc := s.nextScenario(endpoint)
+	status, body := s.route(endpoint, query)
+	s.mu.Unlock()
+
+	if sc != nil {
+		sc.apply(w, r, status, body)
+		return
+	}
+	write(w, status, body)
+}
+
+// Finds the response of a request. User supplied fixtures take precedence over the bundled ones.
+// The lock has to be held by the caller.
+//
+// Returns the status and the body of the response
+func (s *Server) route(endpoint Endpoint, query url.Values) (int, []byte) {
+	for i := len(s.fixtures) - 1; i >= 0; i-- {
+		f := s.fixtures[i]
+		if f.endpoint == endpoint && matches(query, f.match) {
+			return f.status, f.body
+		}
+	}
+
+	switch endpoint {
+	case EndpointInfo:
+		return http.StatusOK, []byte(bundled[FixtureInfo])
+	case EndpointLocations:
+		if query.Get("x") != "" && query.Get("y") != "" {
+			return http.StatusOK, []byte(bundled[FixtureLocationCoordinates])
+		}
+		if query.Get("query") == "" {
+			return badRequest("missing parameter query or x and y")
+		}
+		return http.StatusOK, []byte(bundled[FixtureLocations])
+	case EndpointConnections:
+		if query.Get("from") == "" || query.Get("to") == "" {
+			return badRequest("missing parameter from or to")
+		}
+		return http.StatusOK, []byte(bundled[FixtureConnections])
+	case EndpointStationboard:
+		if query.Get("station") == "" && query.Get("id") == "" {
+			return badRequest("missing parameter station or id")
+		}
+		return http.StatusOK, []byte(bundled[FixtureStationboard])
+	}
+	return http.StatusNotFound, []byte(`{"errors":[{"message":"not found"}]}`)
+}
+
+// Reports whether the query contains all values of match
+func matches(query url.Values, match url.Values) bool {
+	for key, values := range match {
+		for _, v := range values {
+			if !contains(query[key], v) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Reports whether the value is part of the values
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns a bad request response with an error message like the API
+func badRequest(msg string) (int, []byte) {
+	return http.StatusBadRequest, []byte(fmt.Sprintf(`{"errors":[{"message":%q}]}`, msg))
+}
+
+// Writes a json response
+func write(w http.ResponseWriter, status int, body []byte) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,117 @@
+package opentransporttest
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/minderjan/opentransport-client/opentransport"
+)
+
+func TestServer_Routes(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	c, err := opentransport.New(opentransport.WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	if locations, err := c.Location.Search(ctx, "Zürich"); err != nil || len(locations) == 0 {
+		t.Errorf("Failed to search locations: %v", err)
+	}
+	if locations, err := c.Location.SearchWithCoordinates(ctx, 47.47, 8.30); err != nil || !locations[0].Distance.Valid() {
+		t.Errorf("Failed to search locations by coordinates: %v", err)
+	}
+	if result, err := c.Connection.Search(ctx, "Zürich HB", "Bern", time.Now()); err != nil || len(result.Connections) == 0 {
+		t.Errorf("Failed to search connections: %v", err)
+	}
+	if result, err := c.Stationboard.Search(ctx, "Zürich HB"); err != nil || len(result.Journeys) == 0 {
+		t.Errorf("Failed to search the stationboard: %v", err)
+	}
+	if info, err := c.Info.Info(ctx); err != nil || info.Version != "1.0" {
+		t.Errorf("Failed to query the api info: %v", err)
+	}
+
+	requests := srv.Requests()
+	if len(requests) != 5 || requests[2].Endpoint != EndpointConnections || requests[2].Query.Get("to") != "Bern" {
+		t.Errorf("The requests were not recorded: %+v", requests)
+	}
+
+	// Missing parameters and unknown endpoints
+	for path, want := range map[string]int{"connections?from=Bern": http.StatusBadRequest, "trips": http.StatusNotFound} {
+		r, err := http.Get(srv.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r.Body.Close()
+		if r.StatusCode != want {
+			t.Errorf("Got status %d for %s instead of %d", r.StatusCode, path, want)
+		}
+	}
+}
+
+func TestServer_AddFixture(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	c, _ := opentransport.New(opentransport.WithBaseURL(srv.URL), opentransport.WithRetryPolicy(opentransport.NoRetry))
+	ctx := context.Background()
+
+	srv.AddFixture(EndpointLocations, url.Values{"query": {"Bern"}}, []byte(`{"stations":[{"id":"8507000","name":"Bern"}]}`))
+	srv.AddResponse(EndpointStationboard, url.Values{"station": {"Nowhere"}}, http.StatusNotFound, []byte(`{}`))
+
+	locations, err := c.Location.Search(ctx, "Bern")
+	if err != nil || len(locations) != 1 || locations[0].Name != "Bern" {
+		t.Errorf("The user supplied fixture should be served: %v, %v", locations, err)
+	}
+
+	locations, _ = c.Location.Search(ctx, "Zürich")
+	if len(locations) < 2 {
+		t.Errorf("The bundled fixture should be served for other queries")
+	}
+
+	if _, err := c.Stationboard.Search(ctx, "Nowhere"); !errors.Is(err, opentransport.ErrNotFound) {
+		t.Errorf("Got error %v instead of not found", err)
+	}
+
+	if err := srv.AddFixtureFile(EndpointConnections, nil, "missing.json"); err == nil {
+		t.Errorf("A missing fixture file should be an error")
+	}
+
+	srv.Reset()
+	if locations, _ = c.Location.Search(ctx, "Bern"); len(locations) < 2 {
+		t.Errorf("Reset should remove the user supplied fixtures")
+	}
+	if got := len(srv.Requests()); got != 1 {
+		t.Errorf("Got %d requests after reset instead of 1", got)
+	}
+}
+
+func TestFixture(t *testing.T) {
+	for _, name := range []string{FixtureInfo, FixtureLocations, FixtureLocationCoordinates, FixtureConnections, FixtureStationboard} {
+		got, ok := Fixture(name)
+		if !ok {
+			t.Errorf("The fixture %s is not bundled", name)
+			continue
+		}
+
+		// The bundled fixtures have to match the files of the opentransport package
+		want, err := ioutil.ReadFile("../opentransport/testdata/" + name + ".json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != string(want) {
+			t.Errorf("The fixture %s is outdated, run go generate", name)
+		}
+	}
+
+	if _, ok := Fixture("unknown"); ok {
+		t.Errorf("An unknown fixture should not be found")
+	}
+}